@@ -0,0 +1,91 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// loggersResponse describes the JSON payload returned by AdminHandler on GET /loggers.
+type loggersResponse struct {
+	Level   Level            `json:"level"`
+	Loggers map[string]Level `json:"loggers"`
+}
+
+// levelRequest describes the JSON payload accepted by AdminHandler on POST /loggers/{name}.
+type levelRequest struct {
+	Level Level `json:"level"`
+}
+
+// AdminHandler returns an http.Handler exposing the backend's root and per-logger levels,
+// mirroring the sys/loggers admin endpoint found in Vault. This is the canonical, fullest of this
+// package's three level-control handlers — prefer it for new code. The package-level LevelHandler
+// and Backend.LevelHandler expose the same underlying reads and writes (RootLevel/CustomLevels/
+// NamedLevels and SetLevel) under their own, narrower wire shapes kept for existing callers; see
+// their doc comments for the deprecation/scope note on each.
+//
+// GET /loggers returns the root Config.Level plus every Config.CustomLevels entry.
+//
+// POST /loggers/{name} with body {"level":"debug"} sets a custom level for name, reconfiguring
+// in place any named Logger already vended by Backend.NewNamedLogger. Root Config.Level is left
+// untouched, so a lowered root level never overrides a logger's own override (IndependentLevels).
+//
+// DELETE /loggers/{name} drops the custom level, falling the named logger back to the root level.
+func (backend *Backend) AdminHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.Trim(strings.TrimPrefix(r.URL.Path, "/loggers"), "/")
+
+		switch {
+		case r.Method == http.MethodGet && name == "":
+			backend.handleListLoggers(w)
+		case r.Method == http.MethodPost && name != "":
+			backend.handleSetLoggerLevel(w, r, name)
+		case r.Method == http.MethodDelete && name != "":
+			backend.handleResetLoggerLevel(w, name)
+		default:
+			http.Error(w, fmt.Sprintf("%v: unsupported %s %s", Error, r.Method, r.URL.Path), http.StatusNotFound)
+		}
+	})
+}
+
+// handleListLoggers writes the root level and every custom per-logger level as JSON.
+func (backend *Backend) handleListLoggers(w http.ResponseWriter) {
+	resp := loggersResponse{
+		Level:   backend.RootLevel(),
+		Loggers: backend.CustomLevels(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp) //nolint:errcheck
+}
+
+// handleSetLoggerLevel decodes the request body and applies the requested level to name.
+func (backend *Backend) handleSetLoggerLevel(w http.ResponseWriter, r *http.Request, name string) {
+	var req levelRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("%v: decode request: %v", Error, err), http.StatusBadRequest)
+		return
+	}
+
+	backend.SetLevel(name, req.Level)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleResetLoggerLevel drops the custom level for name, falling it back to the root level.
+func (backend *Backend) handleResetLoggerLevel(w http.ResponseWriter, name string) {
+	backend._mu.Lock()
+	delete(backend._config.CustomLevels, name)
+	rootLevel := backend._config.Level
+
+	if logger, ok := backend._named[name]; ok {
+		if setter, ok := logger.(levelSetter); ok {
+			setter.setLevel(rootLevel)
+		}
+	}
+	backend._mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,57 @@
+package logging_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/logging"
+)
+
+func TestBackend_AdminHandler(t *testing.T) {
+	backend := new(logging.Backend)
+	require.NotPanics(t, func() { backend.MustInit(*logging.NewConfig()) })
+
+	handler := backend.AdminHandler()
+
+	logger := backend.NewNamedLogger("admin-test")
+	require.Equal(t, logging.DefaultLevel, logger.Level())
+
+	t.Run("get lists root level", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/loggers", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var body struct {
+			Level   logging.Level            `json:"level"`
+			Loggers map[string]logging.Level `json:"loggers"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		require.Equal(t, logging.DefaultLevel, body.Level)
+	})
+
+	t.Run("post sets named logger level in place", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/loggers/admin-test", strings.NewReader(`{"level":"debug"}`))
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		require.Equal(t, logging.LevelDebug, logger.Level())
+	})
+
+	t.Run("delete resets named logger to root level", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/loggers/admin-test", nil))
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		require.Equal(t, logging.DefaultLevel, logger.Level())
+	})
+
+	t.Run("unknown route", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPut, "/loggers", nil))
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -3,49 +3,70 @@ package logging
 import (
 	"context"
 	"fmt"
-	"os"
+	"strings"
 	"sync"
-	"time"
-
-	"github.com/imperfectgo/zap-syslog"
-	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
-
-	"github.com/imperfectgo/zap-syslog/syslog"
+	"sync/atomic"
 )
 
-// Backend implements logging.Backend using zap.Logger.
+// Backend implements logging.Backend, delegating actual log construction to a pluggable
+// Provider engine selected by Config.Engine (zap by default).
 type Backend struct {
-	_mu     sync.Mutex // protect global _core
-	_core   *zap.Logger
-	_config Config
+	_mu       sync.Mutex // protect global _provider, _config and _named
+	_provider Provider
+	_config   Config
+	_named    map[string]Logger // tracks vended named loggers to allow runtime level changes
+	_paused   map[string]bool   // tracks writer names paused via PauseEventWriter
+
+	_samplingDropped atomic.Uint64 // total records suppressed by sampling; see SamplingDropped
+}
+
+// namedLogger is implemented by provider-specific Logger types supporting NewNamedLogger naming.
+type namedLogger interface {
+	named(name string) Logger
+}
+
+// levelSetter is implemented by provider-specific Logger types supporting in-place level changes,
+// used by AdminHandler to propagate runtime level changes to already-vended named loggers.
+type levelSetter interface {
+	setLevel(Level)
+}
+
+// rootLeveler is implemented by provider-specific Provider types backing their root writer core
+// with a live level enabler, used by SetLevel to apply a runtime root level change to already-built
+// cores without rebuilding the provider. Only the zap engine implements it currently.
+type rootLeveler interface {
+	setRootLevel(Level)
+}
+
+// newProvider builds the Provider selected by config.Engine.
+func newProvider(config Config) (Provider, error) {
+	switch config.Engine {
+	case EngineZerolog:
+		return newZerologProvider(config)
+	case EngineZap, "":
+		return newZapProvider(config)
+	default:
+		return nil, fmt.Errorf("%w: unknown engine: %v", Error, config.Engine)
+	}
 }
 
 // Init do logging backend initialisation. Returns error if initialisation failed.
 // If core already initialized it just validates config and remembers it settings.
 func (backend *Backend) Init(config Config) (err error) {
-	var (
-		encoder zapcore.Encoder
-		syncer  zapcore.WriteSyncer
-		_c      zapcore.Core
-	)
+	var provider Provider
 
-	if backend._core != nil {
+	if backend._provider != nil {
 		return fmt.Errorf("%w: already configured", Error)
 	}
 
-	if encoder, err = backend.makeEncoder(config); err != nil {
-		return err
-	}
+	config.Writers = config.effectiveWriters()
 
-	if syncer, err = Output(config.Output.String()); err != nil {
+	if provider, err = newProvider(config); err != nil {
 		return err
 	}
 
-	_c = zapcore.NewCore(encoder, syncer, zapLevel(config.Level))
-
 	backend._mu.Lock()
-	backend._core = zap.New(_c).WithOptions(backend.makeOptions(config)...)
+	backend._provider = provider
 	backend._config = config
 	backend._mu.Unlock()
 
@@ -66,20 +87,34 @@ func (backend *Backend) MustInit(config Config) {
 // NOTE: this logger requires Sync() called manually to write any buffered log entryTable before exit.
 // To Have automatically synced logger use NewLoggerCtx instead.
 func (backend *Backend) NewLogger(levels ...Level) Logger {
-	return backend.newLoggerForLevels(levels...)
+	return backend.applySampling(backend.newLoggerForLevels(levels...))
 }
 
 // NewLoggerCtx initializes a new provider instance with automatic flushing any buffered log entryTable on context done.
 // Takes logger context and optional logger level to set.
 // If no logging level specified DefaultLevel will set instead.
+// Automatically adds logging keys from context using any installed with WithContextExtractors.
 // Panics if global logging.Init is not called before.
+//
+// This reads ctx, it does not attach the returned Logger back onto it: ctx is immutable, so doing
+// that would mean returning a new context.Context alongside the Logger, which would break every
+// existing caller of this method. Callers that want the vended Logger retrievable downstream via
+// FromCtx must opt in explicitly with AssociateContext(ctx, logger).
 func (backend *Backend) NewLoggerCtx(ctx context.Context, levels ...Level) Logger {
-	logger := backend.newLoggerForLevels(levels...)
+	logger := backend.applySampling(backend.newLoggerForLevels(levels...))
+
+	backend._mu.Lock()
+	config := backend._config
+	backend._mu.Unlock()
+
+	logger = logger.WithKeys(config.contextKeys(ctx))
 
 	go func() {
 		<-ctx.Done()
 
-		_ = logger.Sync() //nolint:nolintlint,errcheck
+		if syncer, ok := logger.(Syncer); ok {
+			_ = syncer.Sync() //nolint:nolintlint,errcheck
+		}
 	}()
 
 	return logger
@@ -93,149 +128,347 @@ func (backend *Backend) NewLoggerCtx(ctx context.Context, levels ...Level) Logge
 // To Have automatically synced logger use NewNamedLoggerCtx instead.
 // Panics if global logging.Init is not called before.
 func (backend *Backend) NewNamedLogger(name string, levels ...Level) (logger Logger) {
-	if custom, ok := backend._config.CustomLevels[name]; ok {
+	backend._mu.Lock()
+	custom, ok := backend._config.CustomLevels[name]
+	backend._mu.Unlock()
+
+	if ok {
 		levels = append(levels, custom) // custom is not overlaps argument if provided, but can be first
 	}
 
-	return backend.makeNamed(backend.newLoggerForLevels(levels...), name)
+	named := backend.makeNamed(backend.newLoggerForLevels(levels...), name)
+
+	return backend.applySampling(named)
 }
 
 // NewNamedLoggerCtx initializes a new named provider instance providing flushing buffered log entryTable on context done.
 // Takes logger buffering context, logger name and optional logging level to set.
 // If no logging level specified DefaultLevel will set instead.
+// Automatically adds logging keys from context using any installed with WithContextExtractors.
 // Panics if global logging.Init is not called before.
+//
+// Same caveat as NewLoggerCtx: this reads ctx but cannot attach the returned Logger back onto it.
+// Use AssociateContext(ctx, logger) explicitly if the Logger must be retrievable via FromCtx
+// downstream.
 func (backend *Backend) NewNamedLoggerCtx(ctx context.Context, name string, levels ...Level) Logger {
-	logger := (backend.NewNamedLogger(name, levels...)).(*zapLogger)
+	logger := backend.NewNamedLogger(name, levels...)
+
+	backend._mu.Lock()
+	config := backend._config
+	backend._mu.Unlock()
+
+	logger = logger.WithKeys(config.contextKeys(ctx))
 
 	go func() {
 		<-ctx.Done()
 
-		_ = logger.Sync() //nolint:errcheck
+		if syncer, ok := logger.(Syncer); ok {
+			_ = syncer.Sync() //nolint:errcheck
+		}
 	}()
 
 	return logger
 }
 
-// makeEncoder makes a zapcore.Encoder for zapcore configuration.
-func (backend *Backend) makeEncoder(config Config) (zapcore.Encoder, error) {
-	encoderConfig := backend.makeConfig(config)
+// newLoggerForLevels builds a Logger from the currently configured Provider at the given level.
+// Lazily initializes a default backend if none was configured yet.
+func (backend *Backend) newLoggerForLevels(levels ...Level) Logger {
+	backend._mu.Lock()
+	if backend._provider == nil {
+		backend._mu.Unlock()
+		backend.MustInit(*NewConfig())
+		backend._mu.Lock()
+	}
 
-	if config.Output == SysLog {
-		syslogEncoderConfig := zapsyslog.SyslogEncoderConfig{
-			EncoderConfig: encoderConfig,
-			Facility:      syslog.LOG_DEBUG,
-			Hostname:      "localhost",
-			PID:           os.Getpid(),
-			App:           os.Args[0],
+	provider := backend._provider
+	config := backend._config
+	backend._mu.Unlock()
+
+	level := DefaultLevel
+	if len(levels) > 0 {
+		level = levels[0]
+	}
+
+	logger, err := provider.NewLogger(config, level)
+	if err != nil {
+		panic(err)
+	}
+
+	return logger
+}
+
+// applySampling wraps logger with a samplingLogger when sampling is configured, rate-limiting
+// repeated records at the same (level, message) fingerprint. A no-op when SamplingTickPerSec is
+// not greater than zero. Must only be applied to the value returned to the caller, never to what
+// makeNamed stores in backend._named, so named-logger naming and runtime level control keep
+// working through the namedLogger/levelSetter type assertions.
+func (backend *Backend) applySampling(logger Logger) Logger {
+	backend._mu.Lock()
+	config := backend._config
+	backend._mu.Unlock()
+
+	if config.SamplingTickPerSec <= 0 {
+		return logger
+	}
+
+	return newSamplingLogger(logger, config.SamplingTickPerSec, config.SamplingFirst, config.SamplingThereafter, &backend._samplingDropped)
+}
+
+// SamplingDropped returns the total number of records suppressed by sampling (see WithSampling)
+// across every logger this Backend has vended, for monitoring suppression under bursty load.
+// Always zero when sampling is not configured.
+func (backend *Backend) SamplingDropped() uint64 {
+	return backend._samplingDropped.Load()
+}
+
+// SetLevel applies level at runtime without restart. An empty or "root" name updates Config.Level
+// and, when the underlying Provider implements rootLeveler (the zap engine), the live level enabler
+// backing its root writer core, so already-vended unnamed loggers immediately become stricter even
+// though each still gates calls against its own fixed Logger.level field first. Any other name
+// updates Config.CustomLevels and reconfigures in place every already-vended named Logger at name
+// or at a '.'-separated descendant path of it (e.g. setting "db" also reaches an already-vended
+// "db.postgres.pool", unless that descendant has its own, more specific CustomLevels entry, which
+// continues to take precedence per levelForNamed), via levelSetter. Pairs with LevelHandler, or a
+// SIGHUP handler wired up by the caller, to adjust verbosity without restart.
+func (backend *Backend) SetLevel(name string, level Level) {
+	backend._mu.Lock()
+	defer backend._mu.Unlock()
+
+	if name == "" || name == "root" {
+		backend._config.Level = level
+
+		if leveler, ok := backend._provider.(rootLeveler); ok {
+			leveler.setRootLevel(level)
 		}
-		return zapsyslog.NewSyslogEncoder(syslogEncoderConfig), nil
+
+		return
 	}
 
-	switch config.Format {
-	case FormatText:
-		return zapcore.NewConsoleEncoder(encoderConfig), nil
-	case FormatJSON:
-		return zapcore.NewJSONEncoder(encoderConfig), nil
-	default:
-		return nil, fmt.Errorf("%w: unknown format: %v", Error, config.Format)
+	backend._config.SetModuleLevel(name, level)
+
+	for named, logger := range backend._named {
+		if named != name && !strings.HasPrefix(named, name+".") {
+			continue
+		}
+
+		setter, ok := logger.(levelSetter)
+		if !ok {
+			continue
+		}
+
+		setter.setLevel(backend._config.levelForNamed(named))
 	}
 }
 
-func (backend *Backend) makeOptions(config Config) []zap.Option {
-	options := make([]zap.Option, 0)
-	if config.Output == StdOut { // addEntry stacktrace only for console
-		options = append(options, zap.AddStacktrace(zapcore.FatalLevel))
+// RootLevel returns the current root Config.Level. Shared by AdminHandler, the package-level
+// LevelHandler and Backend.LevelHandler so all three report the same root level from one place
+// instead of each locking backend._mu and reading backend._config.Level independently.
+func (backend *Backend) RootLevel() Level {
+	backend._mu.Lock()
+	defer backend._mu.Unlock()
+
+	return backend._config.Level
+}
+
+// CustomLevels returns a snapshot copy of Config.CustomLevels, the per-path levels set via SetLevel
+// or SetModuleLevel. Shared by AdminHandler's GET /loggers listing.
+func (backend *Backend) CustomLevels() map[string]Level {
+	backend._mu.Lock()
+	defer backend._mu.Unlock()
+
+	levels := make(map[string]Level, len(backend._config.CustomLevels))
+	for name, level := range backend._config.CustomLevels {
+		levels[name] = level
 	}
 
-	if config.Level == LevelDebug || config.Level == LevelTrace {
-		options = append(options, zap.AddCaller())
+	return levels
+}
+
+// NamedLevels returns a snapshot of every named Logger vended so far, keyed by name, at its
+// current live level (Logger.Level(), not the possibly stale CustomLevels entry it was vended
+// with). Shared by the package-level LevelHandler's GET listing.
+func (backend *Backend) NamedLevels() map[string]Level {
+	backend._mu.Lock()
+	defer backend._mu.Unlock()
+
+	levels := make(map[string]Level, len(backend._named))
+	for name, logger := range backend._named {
+		levels[name] = logger.Level()
 	}
 
-	options = append(options, zap.AddCallerSkip(1)) // increase caller frame distance as using per-logger level
+	return levels
+}
 
-	return options
+// PauseEventWriter stops routing records to the named writer without removing its configuration,
+// rebuilding the underlying provider to apply the change. Loggers already vended keep using the
+// provider in effect when they were created; only loggers obtained afterwards see the change.
+// Returns error if no such writer is configured or the provider failed to rebuild.
+func (backend *Backend) PauseEventWriter(name string) error {
+	return backend.setWriterPaused(name, true)
 }
 
-func (backend *Backend) makeConfig(config Config) zapcore.EncoderConfig {
-	var timeEncoder zapcore.TimeEncoder
+// ResumeEventWriter resumes routing records to a writer previously paused with PauseEventWriter.
+func (backend *Backend) ResumeEventWriter(name string) error {
+	return backend.setWriterPaused(name, false)
+}
+
+// setWriterPaused marks name paused/resumed and rebuilds the provider to apply the change.
+func (backend *Backend) setWriterPaused(name string, paused bool) error {
+	backend._mu.Lock()
+	defer backend._mu.Unlock()
+
+	found := false
 
-	switch {
-	case config.Output == SysLog:
-		timeEncoder = zapcore.EpochTimeEncoder
-	case config.Format == FormatText:
-		timeEncoder = func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
-			enc.AppendString(t.Format(TimestampFormatConsole))
+	for _, writer := range backend._config.Writers {
+		if writer.Name == name {
+			found = true
+			break
 		}
-	default: // assume JSON encode
-		timeEncoder = func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
-			enc.AppendString(t.Format(TimestampFormatJSON))
+	}
+
+	if !found {
+		return fmt.Errorf("%w: writer %s unknown", Error, name)
+	}
+
+	if backend._paused == nil {
+		backend._paused = make(map[string]bool)
+	}
+
+	backend._paused[name] = paused
+
+	return backend.rebuildProviderLocked()
+}
+
+// RemoveEventWriter removes the named writer from Config.Writers entirely and rebuilds the
+// underlying provider without it. Returns error if no such writer is configured.
+func (backend *Backend) RemoveEventWriter(name string) error {
+	backend._mu.Lock()
+	defer backend._mu.Unlock()
+
+	writers := backend._config.Writers
+	idx := -1
+
+	for i, writer := range writers {
+		if writer.Name == name {
+			idx = i
+			break
 		}
 	}
 
-	encoderConfig := zapcore.EncoderConfig{ //nolint:exhaustivestruct
-		TimeKey:          KeyTimestamp.String(),
-		LevelKey:         KeyLevel.String(),
-		NameKey:          KeyLogger.String(),
-		CallerKey:        KeyCaller.String(),
-		FunctionKey:      zapcore.OmitKey,
-		MessageKey:       KeyMessage.String(),
-		StacktraceKey:    KeyStackTrace.String(),
-		LineEnding:       zapcore.DefaultLineEnding,
-		EncodeLevel:      zapcore.LowercaseLevelEncoder,
-		EncodeDuration:   zapcore.SecondsDurationEncoder,
-		EncodeCaller:     zapcore.ShortCallerEncoder,
-		EncodeTime:       timeEncoder,
-		ConsoleSeparator: " ",
+	if idx < 0 {
+		return fmt.Errorf("%w: writer %s unknown", Error, name)
 	}
 
-	return encoderConfig
+	remaining := make([]WriterConfig, 0, len(writers)-1)
+	remaining = append(remaining, writers[:idx]...)
+	remaining = append(remaining, writers[idx+1:]...)
+	backend._config.Writers = remaining
+
+	delete(backend._paused, name)
+
+	return backend.rebuildProviderLocked()
 }
 
-// makeNamed makes new logger of original zap type.
-func (backend *Backend) newLoggerForLevels(levels ...Level) *zapLogger {
-	if backend._core == nil {
-		backend.MustInit(*NewConfig())
+// rebuildProviderLocked rebuilds backend._provider from backend._config, excluding any writer
+// names present and true in backend._paused. Caller must hold backend._mu.
+func (backend *Backend) rebuildProviderLocked() error {
+	config := backend._config
+
+	active := make([]WriterConfig, 0, len(config.Writers))
+
+	for _, writer := range config.Writers {
+		if backend._paused[writer.Name] {
+			continue
+		}
+
+		active = append(active, writer)
 	}
 
-	level := DefaultLevel
-	if len(levels) > 0 {
-		level = levels[0]
+	config.Writers = active
+
+	provider, err := newProvider(config)
+	if err != nil {
+		return err
 	}
 
-	return backend.newLogger(level, backend._core)
+	backend._provider = provider
+
+	return nil
 }
 
-// makeNamed makes new logger of original zap type.
-func (backend *Backend) newLogger(level Level, logger *zap.Logger) *zapLogger {
-	return &zapLogger{SugaredLogger: logger.Sugar(), level: level}
+// Rotate triggers rotation (see WithRotation) on every currently configured writer Target that
+// supports it, skipping any not yet opened or not backed by a Rotator. Intended for use from a
+// SIGHUP handler. Returns the first error encountered, if any.
+func (backend *Backend) Rotate() error {
+	for _, target := range backend.writerTargets() {
+		writer, err := writers.get(WriterName(target))
+		if err != nil {
+			continue
+		}
+
+		if err := writer.Rotate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-// makeNamed makes named logger of original zap type.
-func (backend *Backend) makeNamed(logger *zapLogger, name string) Logger {
-	logger.SugaredLogger = logger.SugaredLogger.Named(name)
+// Close flushes and closes the writers backing every currently configured writer Target, releasing
+// their underlying file handles. Loggers obtained before Close must not be used afterwards.
+func (backend *Backend) Close() error {
+	var firstErr error
 
-	return logger
+	for _, target := range backend.writerTargets() {
+		writers.mu.Lock()
+		async := writers.async[WriterName(target)]
+		writers.mu.Unlock()
+
+		if async != nil { // drain buffered entries before the underlying writer is closed
+			if err := async.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+
+		writer, err := writers.get(WriterName(target))
+		if err != nil {
+			continue
+		}
+
+		if err := writer.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
 }
 
-// zapLevel maps logging Level to underlying zapcore.Level.
-// NOTE: LevelTrace has no direct mapping onto zap logging level and mapped to zapcore.DebugLevel.
-func zapLevel(l Level) zapcore.Level {
-	switch l {
-	case LevelTrace:
-		return zapcore.DebugLevel
-	case LevelDebug:
-		return zapcore.DebugLevel
-	case LevelInfo:
-		return zapcore.InfoLevel
-	case LevelWarn:
-		return zapcore.WarnLevel
-	case LevelError:
-		return zapcore.ErrorLevel
-	case LevelPanic:
-		return zapcore.PanicLevel
-	case LevelFatal:
-		return zapcore.FatalLevel
-	default:
-		return zapcore.Level(l)
+// writerTargets returns the Target of every currently configured writer.
+func (backend *Backend) writerTargets() []Target {
+	backend._mu.Lock()
+	defer backend._mu.Unlock()
+
+	targets := make([]Target, 0, len(backend._config.Writers))
+	for _, writer := range backend._config.Writers {
+		targets = append(targets, writer.Target)
+	}
+
+	return targets
+}
+
+// makeNamed names logger using the provider-specific naming if supported and remembers it
+// to allow runtime level changes through AdminHandler.
+func (backend *Backend) makeNamed(logger Logger, name string) Logger {
+	if namer, ok := logger.(namedLogger); ok {
+		logger = namer.named(name)
+	}
+
+	backend._mu.Lock()
+	if backend._named == nil {
+		backend._named = make(map[string]Logger)
 	}
+	backend._named[name] = logger
+	backend._mu.Unlock()
+
+	return logger
 }
@@ -0,0 +1,60 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// rootLevelResponse is the JSON payload returned by Backend.LevelHandler on GET.
+type rootLevelResponse struct {
+	Level Level `json:"level"`
+}
+
+// rootLevelRequest is the JSON payload accepted by Backend.LevelHandler on PUT/POST.
+type rootLevelRequest struct {
+	Level Level `json:"level"`
+}
+
+// LevelHandler returns an http.Handler exposing backend's own root level only, a narrower,
+// single-value counterpart to the package-level LevelHandler and Backend.AdminHandler, which also
+// manage per-logger CustomLevels. Useful when a service only needs one verbosity knob, e.g. wired
+// up to a SIGHUP handler via SetLevel. Reach for AdminHandler instead when per-logger levels
+// matter too; reads go through Backend.RootLevel and writes through Backend.SetLevel, the same
+// methods backing the other two handlers.
+//
+// GET returns the current root level as {"level":"info"}.
+//
+// PUT or POST with body {"level":"debug"} applies the new root level via SetLevel("", level).
+func (backend *Backend) LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			backend.handleRootLevel(w)
+		case http.MethodPut, http.MethodPost:
+			backend.handleSetRootLevel(w, r)
+		default:
+			http.Error(w, fmt.Sprintf("%v: unsupported %s %s", Error, r.Method, r.URL.Path), http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// handleRootLevel writes the current root level as JSON.
+func (backend *Backend) handleRootLevel(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(rootLevelResponse{Level: backend.RootLevel()}) //nolint:errcheck
+}
+
+// handleSetRootLevel decodes the request body and applies the requested root level.
+func (backend *Backend) handleSetRootLevel(w http.ResponseWriter, r *http.Request) {
+	var req rootLevelRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("%v: decode request: %v", Error, err), http.StatusBadRequest)
+		return
+	}
+
+	backend.SetLevel("", req.Level)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,123 @@
+package logging_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/logging"
+)
+
+func TestBackend_SetLevel_RootAppliesLiveToVendedLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "root_level.log")
+
+	config := logging.NewConfig()
+	config.Apply(
+		logging.WithTarget(logging.Target(path)),
+		logging.WithFormat(logging.FormatJSON),
+		logging.WithLevel(logging.LevelDebug),
+	)
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	logger := backend.NewLogger(logging.LevelDebug)
+	logger.Debug("first")
+
+	backend.SetLevel("", logging.LevelWarn)
+
+	logger.Debug("second")
+	logger.Warn("third")
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	require.Contains(t, string(content), "first")
+	require.NotContains(t, string(content), "second", "root level raised live should gate an already-vended logger's core")
+	require.Contains(t, string(content), "third")
+}
+
+func TestBackend_SetLevel_NamedUpdatesVendedNamedLogger(t *testing.T) {
+	require.NoError(t, logging.Init())
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*logging.NewConfig()))
+
+	logger := backend.NewNamedLogger("set-level-named-test")
+	require.Equal(t, logging.DefaultLevel, logger.Level())
+
+	backend.SetLevel("set-level-named-test", logging.LevelTrace)
+
+	require.Equal(t, logging.LevelTrace, logger.Level())
+}
+
+func TestBackend_SetLevel_UpdatesVendedDescendants(t *testing.T) {
+	require.NoError(t, logging.Init())
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*logging.NewConfig()))
+
+	child := backend.NewNamedLogger("db.postgres.pool")
+	grandchild := backend.NewNamedLogger("db.postgres.pool.conn")
+	require.Equal(t, logging.DefaultLevel, child.Level())
+	require.Equal(t, logging.DefaultLevel, grandchild.Level())
+
+	backend.SetLevel("db", logging.LevelDebug)
+	require.Equal(t, logging.LevelDebug, child.Level(), "a vended descendant must pick up its ancestor's new level")
+	require.Equal(t, logging.LevelDebug, grandchild.Level(), "so must a more distant vended descendant")
+
+	backend.SetLevel("db.postgres.pool", logging.LevelError)
+	require.Equal(t, logging.LevelError, child.Level())
+	require.Equal(t, logging.LevelError, grandchild.Level(), "a closer ancestor override reaches its own descendants too")
+
+	backend.SetLevel("db", logging.LevelWarn)
+	require.Equal(t, logging.LevelError, child.Level(), "a more specific override on db.postgres.pool must keep shadowing the broader db change")
+	require.Equal(t, logging.LevelError, grandchild.Level())
+}
+
+func TestBackend_LevelHandler(t *testing.T) {
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*logging.NewConfig()))
+
+	handler := backend.LevelHandler()
+
+	t.Run("get reports root level", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var body struct {
+			Level logging.Level `json:"level"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		require.Equal(t, logging.DefaultLevel, body.Level)
+	})
+
+	t.Run("put sets root level", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		body := strings.NewReader(`{"level":"error"}`)
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPut, "/", body))
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		listed := httptest.NewRecorder()
+		handler.ServeHTTP(listed, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		var got struct {
+			Level logging.Level `json:"level"`
+		}
+		require.NoError(t, json.NewDecoder(listed.Body).Decode(&got))
+		require.Equal(t, logging.LevelError, got.Level)
+	})
+
+	t.Run("unsupported method", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/", nil))
+		require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
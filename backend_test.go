@@ -1,6 +1,12 @@
 package logging_test
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 
@@ -9,6 +15,118 @@ import (
 	"github.com/amarin/logging"
 )
 
+// TestBackend_Engines proves Format, Target, CustomLevels and context-extractor behavior work
+// identically through both engines: for the same Config, each engine must gate the same message by
+// the same CustomLevels override and must render the same message/level/key/error/context fields,
+// not merely "run without panicking".
+func TestBackend_Engines(t *testing.T) {
+	for _, engine := range []logging.Engine{logging.EngineZap, logging.EngineZerolog} {
+		t.Run(engine.String(), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "engine.log")
+
+			require.NoError(t, logging.Init(
+				logging.WithEngine(engine),
+				logging.WithTarget(logging.Target(path)),
+				logging.WithFormat(logging.FormatJSON),
+				logging.WithLevel(logging.LevelTrace), // permissive root so the CustomLevels override below can only tighten, never loosen past it
+				logging.WithContextExtractors(logging.Key("req_id").Extractor()),
+			))
+
+			config := logging.CurrentConfig()
+			config.CustomLevels["engine-test"] = logging.LevelError
+
+			backend := new(logging.Backend)
+			require.NoError(t, backend.Init(*config))
+
+			logger := backend.NewNamedLogger("engine-test")
+			require.Equal(t, logging.LevelError, logger.Level(), "CustomLevels override must resolve identically across engines")
+
+			ctx := logging.Key("req_id").SetToCtx(context.Background(), "abc123")
+			logger = logger.WithKey("key", "value").WithError(errors.New("sample error")).WithContext(ctx)
+
+			logger.Debug("below the custom level, must not reach the sink")
+			logger.Error("at the custom level, must reach the sink")
+
+			content, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+			require.Len(t, lines, 1, "only the Error record should have passed the CustomLevels override")
+
+			var record map[string]any
+			require.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+
+			require.Equal(t, "at the custom level, must reach the sink", record["msg"])
+			require.Equal(t, "error", record["level"])
+			require.Equal(t, "value", record["key"])
+			require.Equal(t, "abc123", record["req_id"])
+			require.Contains(t, record, "error")
+		})
+	}
+}
+
+func TestBackend_Formats(t *testing.T) {
+	for _, engine := range []logging.Engine{logging.EngineZap, logging.EngineZerolog} {
+		for _, format := range []logging.Format{logging.FormatText, logging.FormatJSON, logging.FormatLogfmt} {
+			t.Run(engine.String()+"_"+format.String(), func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "format.log")
+
+				config := logging.NewConfig()
+				config.Engine = engine
+				config.Format = format
+				config.Apply(logging.WithTarget(logging.Target(path)))
+
+				backend := new(logging.Backend)
+				require.NoError(t, backend.Init(*config))
+
+				logger := backend.NewLogger(logging.LevelInfo)
+				logger.WithKey("key", "value").Info("test message")
+
+				content, err := os.ReadFile(path)
+				require.NoError(t, err)
+				require.Contains(t, string(content), "test message", "the rendered record must contain the message regardless of format or engine")
+				require.Contains(t, string(content), "value", "the rendered record must contain WithKey's value regardless of format or engine")
+			})
+		}
+	}
+}
+
+// TestBackend_Engines_SysLog proves both engines accept Output: SysLog identically: neither Init
+// nor a write to it should error just because no syslog daemon is listening on the UDP socket.
+func TestBackend_Engines_SysLog(t *testing.T) {
+	for _, engine := range []logging.Engine{logging.EngineZap, logging.EngineZerolog} {
+		t.Run(engine.String(), func(t *testing.T) {
+			config := logging.NewConfig()
+			config.Engine = engine
+			config.Apply(logging.WithTarget(logging.SysLog))
+
+			backend := new(logging.Backend)
+			require.NoError(t, backend.Init(*config))
+			require.NotPanics(t, func() { backend.NewLogger(logging.LevelInfo).Info("syslog message") })
+		})
+	}
+}
+
+// BenchmarkZapLogger_DisabledLevel logs through a logger chained with ten WithKey calls and a
+// level above the one being logged at, demonstrating the disabled-level path allocates next to
+// nothing regardless of how many fields are attached to the logger.
+func BenchmarkZapLogger_DisabledLevel(b *testing.B) {
+	backend := new(logging.Backend)
+	backend.MustInit(*logging.NewConfig())
+
+	logger := backend.NewLogger(logging.LevelInfo)
+	for i := 0; i < 10; i++ {
+		logger = logger.WithKey("key", i)
+	}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		logger.Debug("disabled debug message")
+	}
+}
+
 func TestBackend_MultipleInstances(t *testing.T) {
 	maxBackends := 1000
 	config := logging.CurrentConfig()
@@ -14,7 +14,9 @@ Default is output to console (stdout) and use text format on info level.
 */
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 )
 
 // CurrentConfig returns pointer to stored config.
@@ -46,9 +48,40 @@ type Config struct {
 	// CustomLevels allows separate level definitions for named loggers using their names.
 	// Each specified level expected to be less verbose than global level defined in Config.Level attribute.
 	CustomLevels map[string]Level `yaml:"customLevels,omitempty"`
+	// Engine selects the underlying Provider implementation. Either zap or zerolog.
+	// Default EngineZap.
+	Engine Engine `yaml:"engine,omitempty"`
+	// AsyncBufferSize enables asynchronous buffered writing when greater than zero, sizing the
+	// channel AsyncWriter buffers writes onto before they reach the underlying sink.
+	AsyncBufferSize int `yaml:"asyncBufferSize,omitempty"`
+	// AsyncOverflowPolicy selects AsyncWriter behavior once AsyncBufferSize is reached.
+	// Only meaningful when AsyncBufferSize is greater than zero.
+	AsyncOverflowPolicy OverflowPolicy `yaml:"asyncOverflowPolicy,omitempty"`
+	// Writers fans each emitted record out to multiple named targets, each with its own Target,
+	// Format and Level. When nil, a single entry is synthesized from Level/Format/Output.
+	// See WriterConfig and WithEventWriter.
+	Writers []WriterConfig `yaml:"writers,omitempty"`
+	// SamplingTickPerSec divides each second into this many sampling windows; enables sampling
+	// (via a samplingLogger wrapper) when greater than zero. See WithSampling.
+	SamplingTickPerSec int `yaml:"samplingTickPerSec,omitempty"`
+	// SamplingFirst is how many records per (level, message) fingerprint pass through unsampled at
+	// the start of each window. Only meaningful when SamplingTickPerSec is greater than zero.
+	SamplingFirst int `yaml:"samplingFirst,omitempty"`
+	// SamplingThereafter, once SamplingFirst is exceeded, lets through only every Nth subsequent
+	// record with the same fingerprint until the window resets.
+	SamplingThereafter int `yaml:"samplingThereafter,omitempty"`
+	// Rotation configures lumberjack-based rotation for specific file Targets. A Target with no
+	// entry here is opened as a plain file. See WithRotation.
+	Rotation map[Target]RotationConfig `yaml:"rotation,omitempty"`
 
 	// contextExtractors registers extract context-provided data as fields
-	contextExtractors map[Key]ContextExtractorFun
+	contextExtractors map[Key]ContextExtractorFunc
+
+	// resolvedLevels caches levelForNamed resolutions keyed by full logger name.
+	// Shared by reference across Config copies, same as CustomLevels and contextExtractors.
+	// Invalidated wholesale by SetModuleLevel. May be nil on a zero-value Config, in which case
+	// levelForNamed simply resolves without caching.
+	resolvedLevels *sync.Map
 }
 
 // NewConfig creates new logging configuration with defaults set.
@@ -57,8 +90,10 @@ func NewConfig() *Config {
 		Level:             DefaultLevel,
 		Output:            DefaultOutput,
 		Format:            DefaultFormat,
+		Engine:            DefaultEngine,
 		CustomLevels:      make(map[string]Level),
-		contextExtractors: make(map[Key]ContextExtractorFun),
+		contextExtractors: make(map[Key]ContextExtractorFunc),
+		resolvedLevels:    new(sync.Map),
 	}
 }
 
@@ -73,6 +108,10 @@ func (config Config) Validate() error {
 		return err
 	}
 
+	if err := config.Engine.Validate(); err != nil {
+		return err
+	}
+
 	switch config.Level {
 	case LevelTrace, LevelDebug, LevelInfo, LevelWarn, LevelError, LevelPanic, LevelFatal:
 	default:
@@ -87,6 +126,16 @@ func (config Config) Validate() error {
 		}
 	}
 
+	for _, writer := range config.Writers {
+		if err := writer.Format.Validate(); err != nil {
+			return fmt.Errorf("%w: writer %s: %v", Error, writer.Name, err)
+		}
+
+		if writer.Target == "" {
+			return fmt.Errorf("%w: writer %s: empty target", Error, writer.Name)
+		}
+	}
+
 	return nil
 }
 
@@ -116,22 +165,115 @@ func (config Config) String() string {
 }
 
 // levelForNamed returns configured level for named logger.
-// Returns first specified layer if no custom layer set for logger or config global layer.
+// If name has no direct CustomLevels entry, it walks name's '.'-separated path from most specific
+// to least (e.g. "db.postgres.pool" inherits from "db.postgres", then "db") looking for a match,
+// matching the loggo/klog module tree model. Falls back to the first specified level if provided,
+// or config global level otherwise. CustomLevels matches are cached per full name in
+// resolvedLevels; the fallbacks are not cached, as they depend on levels and Config.Level.
 func (config Config) levelForNamed(name string, levels ...Level) Level {
-	var (
-		customLevel Level
-		ok          bool
-	)
+	if config.resolvedLevels != nil {
+		if cached, ok := config.resolvedLevels.Load(name); ok {
+			return cached.(Level) //nolint:forcetypeassert
+		}
+	}
+
+	level, ok := config.resolveModuleLevel(name)
 
-	customLevel, ok = config.CustomLevels[name]
 	switch {
-	case !ok && len(levels) == 0:
-		customLevel = config.Level
-	case !ok:
-		customLevel = levels[0]
+	case ok:
+		if config.resolvedLevels != nil {
+			config.resolvedLevels.Store(name, level)
+		}
+	case len(levels) > 0:
+		level = levels[0]
+	default:
+		level = config.Level
+	}
+
+	return level
+}
+
+// resolveModuleLevel walks name's '.'-separated path from most specific to least, returning the
+// first CustomLevels match found along the way.
+func (config Config) resolveModuleLevel(name string) (Level, bool) {
+	for path := name; path != ""; {
+		if level, ok := config.CustomLevels[path]; ok {
+			return level, true
+		}
+
+		idx := strings.LastIndex(path, ".")
+		if idx < 0 {
+			break
+		}
+
+		path = path[:idx]
+	}
+
+	return 0, false
+}
+
+// SetModuleLevel records lvl as the custom level for path, invalidating any cached levelForNamed
+// resolutions so path and its descendants pick up the new value on next lookup.
+func (config *Config) SetModuleLevel(path string, lvl Level) {
+	if config.CustomLevels == nil {
+		config.CustomLevels = make(map[string]Level)
+	}
+
+	config.CustomLevels[path] = lvl
+
+	if config.resolvedLevels != nil {
+		config.resolvedLevels = new(sync.Map)
+	}
+}
+
+// ConfigureLoggers parses a ';'-separated list of "path=LEVEL" assignments, e.g.
+// "db.postgres=DEBUG;http=WARN", and applies each to the current global configuration via
+// SetModuleLevel.
+func ConfigureLoggers(spec string) error {
+	mu.Lock()
+	config := useConfig
+	mu.Unlock()
+
+	for _, assignment := range strings.Split(spec, ";") {
+		assignment = strings.TrimSpace(assignment)
+		if assignment == "" {
+			continue
+		}
+
+		parts := strings.SplitN(assignment, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("%w: invalid logger level assignment %q", Error, assignment)
+		}
+
+		var level Level
+		if err := level.UnmarshalText([]byte(strings.TrimSpace(parts[1]))); err != nil {
+			return err
+		}
+
+		config.SetModuleLevel(strings.TrimSpace(parts[0]), level)
+	}
+
+	return nil
+}
+
+// DumpLoggerLevels renders the current global CustomLevels as a ConfigureLoggers-compatible
+// "path=LEVEL;..." string, sorted by path, useful for diagnostics.
+func DumpLoggerLevels() string {
+	config := CurrentConfig()
+
+	names := make([]string, 0, len(config.CustomLevels))
+	for name := range config.CustomLevels {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name + "=" + strings.ToUpper(config.CustomLevels[name].String())
 	}
 
-	return customLevel
+	return strings.Join(parts, ";")
 }
 
 type Option func(*Config)
@@ -279,3 +279,39 @@ func TestConfig_String(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_LevelForNamed_Hierarchical(t *testing.T) {
+	require.NoError(t, Init())
+	require.NoError(t, ConfigureLoggers("db=INFO;db.postgres=DEBUG"))
+
+	pool := NewNamedLogger("db.postgres.pool")
+	require.Equal(t, LevelDebug, pool.Level(), "inherits from nearest ancestor")
+
+	mysql := NewNamedLogger("db.mysql")
+	require.Equal(t, LevelInfo, mysql.Level(), "inherits from parent")
+
+	httpLogger := NewNamedLogger("http")
+	require.Equal(t, DefaultLevel, httpLogger.Level(), "falls back to root level")
+
+	require.NoError(t, ConfigureLoggers("db.postgres=TRACE"))
+	reloaded := NewNamedLogger("db.postgres.pool")
+	require.Equal(t, LevelTrace, reloaded.Level(), "cache invalidated by SetModuleLevel")
+}
+
+func TestConfigureLoggers(t *testing.T) {
+	require.NoError(t, Init())
+
+	require.NoError(t, ConfigureLoggers("db.postgres=DEBUG;http=WARN"))
+	require.Equal(t, LevelDebug, NewNamedLogger("db.postgres.pool").Level())
+	require.Equal(t, LevelWarn, NewNamedLogger("http").Level())
+
+	require.Error(t, ConfigureLoggers("not-an-assignment"))
+	require.Error(t, ConfigureLoggers("bad.logger=not-a-level"))
+}
+
+func TestDumpLoggerLevels(t *testing.T) {
+	require.NoError(t, Init())
+	require.NoError(t, ConfigureLoggers("db.postgres=DEBUG;http=WARN"))
+
+	require.Equal(t, "db.postgres=DEBUG;http=WARN", DumpLoggerLevels())
+}
@@ -2,6 +2,7 @@ package logging
 
 import (
 	"context"
+	"sync"
 )
 
 // ContextExtractorFunc is a function returning field name
@@ -64,3 +65,47 @@ func KeysCtx(ctx context.Context) Keys {
 
 	return cfg.contextKeys(ctx)
 }
+
+// loggerContextKey is the private context key type used by CtxWithLogger/FromCtx to stash a Logger.
+type loggerContextKey struct{}
+
+var (
+	packageLoggerMu sync.Mutex
+	packageLogger   Logger
+)
+
+// CtxWithLogger returns a copy of ctx carrying logger, retrievable later with FromCtx.
+func CtxWithLogger(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, logger)
+}
+
+// FromCtx returns the Logger attached to ctx with CtxWithLogger, or a package default Logger
+// if ctx carries none. This lets goroutines spawned from a request handler pick up the same
+// keyed logger without threading it as an argument.
+func FromCtx(ctx context.Context) Logger {
+	if logger, ok := ctx.Value(loggerContextKey{}).(Logger); ok {
+		return logger
+	}
+
+	packageLoggerMu.Lock()
+	defer packageLoggerMu.Unlock()
+
+	if packageLogger == nil {
+		packageLogger = new(Default)
+	}
+
+	return packageLogger
+}
+
+// AssociateContext returns a copy of ctx carrying logger, retrievable later with FromContext.
+// An alias for CtxWithLogger, named to match context-logger propagation conventions used by other
+// logging packages.
+func AssociateContext(ctx context.Context, logger Logger) context.Context {
+	return CtxWithLogger(ctx, logger)
+}
+
+// FromContext returns the Logger attached to ctx with AssociateContext, or a package default
+// Logger if ctx carries none. An alias for FromCtx.
+func FromContext(ctx context.Context) Logger {
+	return FromCtx(ctx)
+}
@@ -2,6 +2,8 @@ package logging
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -34,3 +36,58 @@ func TestWithContextExtractors(t *testing.T) {
 		require.NotContains(t, keys, key)
 	})
 }
+
+func TestCtxWithLogger(t *testing.T) {
+	require.NoError(t, Init())
+
+	t.Run("FromCtx returns a package default when ctx carries none", func(t *testing.T) {
+		require.NotNil(t, FromCtx(context.Background()))
+	})
+
+	t.Run("FromCtx returns the logger attached with CtxWithLogger", func(t *testing.T) {
+		logger := NewLogger(LevelDebug)
+		ctx := CtxWithLogger(context.Background(), logger)
+
+		require.Equal(t, logger, FromCtx(ctx))
+	})
+}
+
+func TestAssociateContext(t *testing.T) {
+	require.NoError(t, Init())
+
+	t.Run("FromContext returns a package default when ctx carries none", func(t *testing.T) {
+		require.NotNil(t, FromContext(context.Background()))
+	})
+
+	t.Run("FromContext returns the logger attached with AssociateContext", func(t *testing.T) {
+		logger := NewLogger(LevelDebug)
+		ctx := AssociateContext(context.Background(), logger)
+
+		require.Equal(t, logger, FromContext(ctx))
+	})
+
+	t.Run("AssociateContext interoperates with CtxWithLogger/FromCtx", func(t *testing.T) {
+		logger := NewLogger(LevelDebug)
+		ctx := AssociateContext(context.Background(), logger)
+
+		require.Equal(t, logger, FromCtx(ctx))
+	})
+}
+
+func TestLogger_WithValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "with_values.log")
+
+	require.NoError(t, Init(
+		WithTarget(Target(path)),
+		WithFormat(FormatJSON),
+		WithContextExtractors(Key("req_id").Extractor()),
+	))
+
+	ctx := Key("req_id").SetToCtx(context.Background(), "abc123")
+	NewLogger(LevelDebug).WithValues(ctx).Info("handled")
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "abc123")
+	require.Contains(t, string(content), "handled")
+}
@@ -31,6 +31,18 @@ func (l *Default) WithKeys(fields Keys) Logger {
 	return l.logger.WithKeys(fields)
 }
 
+// WithKey provides a new logger instance having specified single key-value pair set.
+func (l *Default) WithKey(key string, value any) Logger {
+	l.checkInnerLogger()
+	return l.logger.WithKey(key, value)
+}
+
+// WithError provides a new logger instance having specified error key.
+func (l *Default) WithError(err error) Logger {
+	l.checkInnerLogger()
+	return l.logger.WithError(err)
+}
+
 // WithLevel provides a new logger instance inherit settings from parent except specified logging level.
 func (l *Default) WithLevel(level Level) Logger {
 	l.checkInnerLogger()
@@ -49,6 +61,29 @@ func (l *Default) WithContext(ctx context.Context) Logger {
 	return NewLoggerCtx(ctx, useConfig.Level)
 }
 
+// InfoCtx sends info level data onto logging, first enriching the logger with ctx via WithContext.
+func (l *Default) InfoCtx(ctx context.Context, args ...interface{}) {
+	l.checkInnerLogger()
+	l.logger.InfoCtx(ctx, args...)
+}
+
+// WithValues is an alias for WithContext.
+func (l *Default) WithValues(ctx context.Context) Logger {
+	return l.WithContext(ctx)
+}
+
+// WithErrorCtx provides a new logger instance having specified error key and ctx data applied.
+func (l *Default) WithErrorCtx(ctx context.Context, err error) Logger {
+	l.checkInnerLogger()
+	return l.logger.WithErrorCtx(ctx, err)
+}
+
+// WithSamplingDisabled provides a new logger instance bypassing sampling.
+func (l *Default) WithSamplingDisabled() Logger {
+	l.checkInnerLogger()
+	return l.logger.WithSamplingDisabled()
+}
+
 // Trace sends trace level data onto logging.
 func (l *Default) Trace(args ...interface{}) {
 	l.checkInnerLogger()
@@ -0,0 +1,40 @@
+package logging
+
+import "fmt"
+
+const (
+	// EngineZap selects go.uber.org/zap as the logging engine. This is the DefaultEngine.
+	EngineZap Engine = "zap"
+
+	// EngineZerolog selects github.com/rs/zerolog as the logging engine.
+	EngineZerolog Engine = "zerolog"
+
+	// DefaultEngine defines default logging engine if omitted.
+	DefaultEngine = EngineZap
+)
+
+// Engine selects the underlying Provider implementation used by Backend.
+type Engine string
+
+// String returns string representation of Engine. Implements fmt.Stringer.
+func (e Engine) String() string {
+	return string(e)
+}
+
+// Validate returns error if Engine value is not known.
+// Empty value is accepted and treated as DefaultEngine.
+func (e Engine) Validate() error {
+	switch e {
+	case "", EngineZap, EngineZerolog:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown engine `%v`, want `%s` or `%s`", Error, e, EngineZap, EngineZerolog)
+	}
+}
+
+// WithEngine adds specified logging engine to configuration.
+func WithEngine(engine Engine) Option {
+	return func(config *Config) {
+		config.Engine = engine
+	}
+}
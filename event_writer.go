@@ -0,0 +1,64 @@
+package logging
+
+// WriterConfig configures a single named target Backend fans emitted records out to. Config.Writers
+// holds any number of these, letting the same record reach multiple sinks at independent levels and
+// formats, e.g. INFO+ as text to stdout while DEBUG+ ships as JSON to a file.
+type WriterConfig struct {
+	// Name identifies the writer for WithEventWriter, Backend.RemoveEventWriter and
+	// Backend.PauseEventWriter. Required when set through Config.Writers directly.
+	Name string `yaml:"name"`
+	// Target selects where this writer's output goes. See Target.
+	Target Target `yaml:"target"`
+	// Format selects this writer's encoding. See Format.
+	Format Format `yaml:"format,omitempty"`
+	// Level gates which records reach this writer.
+	Level Level `yaml:"level,omitempty"`
+	// StacktraceLevel attaches a stacktrace to records at or above this level for this writer only.
+	// Zero value (LevelTrace) leaves the provider-wide default in place instead of opting in.
+	// Honored by the zap engine only; zerolog has no native stacktrace capture in this codebase.
+	StacktraceLevel Level `yaml:"stacktraceLevel,omitempty"`
+}
+
+// effectiveWriters returns config.Writers, or a single entry synthesized from the top-level
+// Level/Format/Output when Writers is nil, preserving single-target backward compatibility.
+// An explicitly non-nil but empty Writers (e.g. every writer paused) is returned as-is.
+func (config Config) effectiveWriters() []WriterConfig {
+	if config.Writers != nil {
+		return config.Writers
+	}
+
+	return []WriterConfig{{
+		Name:   "default",
+		Target: config.Output,
+		Format: config.Format,
+		Level:  config.Level,
+	}}
+}
+
+// WithEventWriter registers or replaces the named entry in Config.Writers.
+func WithEventWriter(name string, cfg WriterConfig) Option {
+	return func(config *Config) {
+		cfg.Name = name
+
+		for i, existing := range config.Writers {
+			if existing.Name == name {
+				config.Writers[i] = cfg
+				return
+			}
+		}
+
+		config.Writers = append(config.Writers, cfg)
+	}
+}
+
+// SinkConfig is an alias for WriterConfig, the vocabulary used by WithSink: each sink carries its
+// own Output (via Target), Format and minimum Level, independent of every other configured sink.
+type SinkConfig = WriterConfig
+
+// WithSink registers or replaces the sink keyed by sink.Name, e.g. a pretty console sink at Info
+// alongside a JSON file sink at Debug. An alias for WithEventWriter(sink.Name, sink); existing
+// single-output configs built from Level/Format/Output keep working unchanged, per
+// effectiveWriters.
+func WithSink(sink SinkConfig) Option {
+	return WithEventWriter(sink.Name, sink)
+}
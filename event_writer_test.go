@@ -0,0 +1,137 @@
+package logging_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/logging"
+)
+
+func TestBackend_EventWriters_FanOut(t *testing.T) {
+	textPath := filepath.Join(t.TempDir(), "text.log")
+	jsonPath := filepath.Join(t.TempDir(), "json.log")
+
+	config := logging.NewConfig()
+	config.Apply(
+		logging.WithEventWriter("console", logging.WriterConfig{
+			Target: logging.Target(textPath),
+			Format: logging.FormatText,
+			Level:  logging.LevelInfo,
+		}),
+		logging.WithEventWriter("debug-file", logging.WriterConfig{
+			Target: logging.Target(jsonPath),
+			Format: logging.FormatJSON,
+			Level:  logging.LevelDebug,
+		}),
+	)
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	logger := backend.NewLogger(logging.LevelDebug)
+	logger.Info("info message")
+	logger.Debug("debug message")
+
+	text, err := os.ReadFile(textPath)
+	require.NoError(t, err)
+	require.Contains(t, string(text), "info message")
+	require.NotContains(t, string(text), "debug message")
+
+	jsonContent, err := os.ReadFile(jsonPath)
+	require.NoError(t, err)
+	require.Contains(t, string(jsonContent), "info message")
+	require.Contains(t, string(jsonContent), "debug message")
+}
+
+func TestBackend_WithSink_FanOut(t *testing.T) {
+	consolePath := filepath.Join(t.TempDir(), "console.log")
+	filePath := filepath.Join(t.TempDir(), "file.log")
+
+	config := logging.NewConfig()
+	config.Apply(
+		logging.WithSink(logging.SinkConfig{
+			Name:   "console",
+			Target: logging.Target(consolePath),
+			Format: logging.FormatText,
+			Level:  logging.LevelInfo,
+		}),
+		logging.WithSink(logging.SinkConfig{
+			Name:   "file",
+			Target: logging.Target(filePath),
+			Format: logging.FormatJSON,
+			Level:  logging.LevelDebug,
+		}),
+	)
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	logger := backend.NewLogger(logging.LevelDebug)
+	logger.Info("info message")
+	logger.Debug("debug message")
+
+	console, err := os.ReadFile(consolePath)
+	require.NoError(t, err)
+	require.Contains(t, string(console), "info message")
+	require.NotContains(t, string(console), "debug message")
+
+	file, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	require.Contains(t, string(file), "info message")
+	require.Contains(t, string(file), "debug message")
+}
+
+func TestBackend_RemoveEventWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.log")
+
+	config := logging.NewConfig()
+	config.Apply(logging.WithEventWriter("file", logging.WriterConfig{
+		Target: logging.Target(path),
+		Format: logging.FormatJSON,
+		Level:  logging.LevelInfo,
+	}))
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	require.Error(t, backend.RemoveEventWriter("unknown"))
+	require.NoError(t, backend.RemoveEventWriter("file"))
+
+	logger := backend.NewLogger(logging.LevelInfo)
+	logger.Info("should not reach file")
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Empty(t, content)
+}
+
+func TestBackend_PauseResumeEventWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "paused.log")
+
+	config := logging.NewConfig()
+	config.Apply(logging.WithEventWriter("file", logging.WriterConfig{
+		Target: logging.Target(path),
+		Format: logging.FormatJSON,
+		Level:  logging.LevelInfo,
+	}))
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	require.NoError(t, backend.PauseEventWriter("file"))
+	backend.NewLogger(logging.LevelInfo).Info("paused message")
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Empty(t, content)
+
+	require.NoError(t, backend.ResumeEventWriter("file"))
+	backend.NewLogger(logging.LevelInfo).Info("resumed message")
+
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "resumed message")
+}
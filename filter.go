@@ -0,0 +1,264 @@
+package logging
+
+import "context"
+
+// FilterOption configures a Logger created with NewFilter.
+type FilterOption func(*filterLogger)
+
+// AllowLevel sets the minimum level permitted through the filter.
+func AllowLevel(level Level) FilterOption {
+	return func(f *filterLogger) {
+		f.minLevel = level
+	}
+}
+
+// AllowAll permits every level through the filter.
+func AllowAll() FilterOption {
+	return AllowLevel(LevelTrace)
+}
+
+// AllowKey raises the allowed level to allowed whenever the wrapped logger's accumulated
+// WithKey/WithKeys fields contain key=value, e.g. AllowKey("module", "p2p", LevelDebug) lets a
+// logger tagged module=p2p through at Debug while everything else stays at the filter's base level.
+func AllowKey(key Key, value any, allowed Level) FilterOption {
+	return func(f *filterLogger) {
+		f.overrides = append(f.overrides, keyOverride{key: key, value: value, level: allowed})
+	}
+}
+
+// keyOverride raises the allowed level whenever fields[key] == value.
+type keyOverride struct {
+	key   Key
+	value any
+	level Level
+}
+
+// filterLogger implements Logger, delegating to next only when a record passes the configured rules.
+type filterLogger struct {
+	next      Logger
+	minLevel  Level
+	overrides []keyOverride
+	fields    Keys // accumulated WithKey/WithKeys fields, resolved against overrides
+	allowed   Level
+}
+
+// NewFilter wraps next so only records passing the configured rules reach it, in the spirit of
+// Tendermint's log/filter.go. Filters compose: NewFilter(NewFilter(next, ...), ...) works as expected.
+func NewFilter(next Logger, opts ...FilterOption) Logger {
+	f := &filterLogger{next: next, minLevel: DefaultLevel, fields: make(Keys)}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	f.allowed = f.effectiveLevel()
+
+	return f
+}
+
+// effectiveLevel resolves the allowed level given the currently accumulated fields and overrides.
+// The most permissive (lowest) matching level wins.
+func (f *filterLogger) effectiveLevel() Level {
+	allowed := f.minLevel
+
+	for _, override := range f.overrides {
+		if v, ok := f.fields[override.key]; ok && v == override.value && override.level < allowed {
+			allowed = override.level
+		}
+	}
+
+	return allowed
+}
+
+// Level returns the filter's current effective allowed level.
+func (f *filterLogger) Level() Level {
+	return f.allowed
+}
+
+// IsEnabledForLevel detects if the filter currently allows messages at the specified level.
+func (f *filterLogger) IsEnabledForLevel(level Level) bool {
+	return f.allowed.IsEnabledForLevel(level)
+}
+
+// Trace sends trace data onto the wrapped logger if allowed.
+func (f *filterLogger) Trace(args ...interface{}) {
+	if f.IsEnabledForLevel(LevelTrace) {
+		f.next.Trace(args...)
+	}
+}
+
+// Tracef sends message template and filling arguments onto the wrapped logger if allowed.
+func (f *filterLogger) Tracef(format string, args ...interface{}) {
+	if f.IsEnabledForLevel(LevelTrace) {
+		f.next.Tracef(format, args...)
+	}
+}
+
+// Debug sends debug data onto the wrapped logger if allowed.
+func (f *filterLogger) Debug(args ...interface{}) {
+	if f.IsEnabledForLevel(LevelDebug) {
+		f.next.Debug(args...)
+	}
+}
+
+// Debugf sends message template and filling arguments onto the wrapped logger if allowed.
+func (f *filterLogger) Debugf(format string, args ...interface{}) {
+	if f.IsEnabledForLevel(LevelDebug) {
+		f.next.Debugf(format, args...)
+	}
+}
+
+// Info sends info level data onto the wrapped logger if allowed.
+func (f *filterLogger) Info(args ...interface{}) {
+	if f.IsEnabledForLevel(LevelInfo) {
+		f.next.Info(args...)
+	}
+}
+
+// Infof sends message template and filling arguments onto the wrapped logger if allowed.
+func (f *filterLogger) Infof(format string, args ...interface{}) {
+	if f.IsEnabledForLevel(LevelInfo) {
+		f.next.Infof(format, args...)
+	}
+}
+
+// Warn sends warn data onto the wrapped logger if allowed.
+func (f *filterLogger) Warn(args ...interface{}) {
+	if f.IsEnabledForLevel(LevelWarn) {
+		f.next.Warn(args...)
+	}
+}
+
+// Warnf sends message template and filling arguments onto the wrapped logger if allowed.
+func (f *filterLogger) Warnf(format string, args ...interface{}) {
+	if f.IsEnabledForLevel(LevelWarn) {
+		f.next.Warnf(format, args...)
+	}
+}
+
+// Error sends error data onto the wrapped logger if allowed.
+func (f *filterLogger) Error(args ...interface{}) {
+	if f.IsEnabledForLevel(LevelError) {
+		f.next.Error(args...)
+	}
+}
+
+// Errorf sends message template and filling arguments onto the wrapped logger if allowed.
+func (f *filterLogger) Errorf(format string, args ...interface{}) {
+	if f.IsEnabledForLevel(LevelError) {
+		f.next.Errorf(format, args...)
+	}
+}
+
+// Fatal sends error data onto the wrapped logger and calls os.exit(1), if allowed. Raise AllowLevel
+// above LevelFatal deliberately if a filter must suppress even process-terminating records.
+func (f *filterLogger) Fatal(args ...interface{}) {
+	if f.IsEnabledForLevel(LevelFatal) {
+		f.next.Fatal(args...)
+	}
+}
+
+// Fatalf sends message template and filling arguments onto the wrapped logger and calls os.exit(1),
+// if allowed. See Fatal.
+func (f *filterLogger) Fatalf(format string, args ...interface{}) {
+	if f.IsEnabledForLevel(LevelFatal) {
+		f.next.Fatalf(format, args...)
+	}
+}
+
+// WithKeys provides a new filter instance propagating fields to next and recomputing the
+// effective allowed level against the override table.
+func (f *filterLogger) WithKeys(fields Keys) Logger {
+	merged := make(Keys, len(f.fields)+len(fields))
+	for k, v := range f.fields {
+		merged[k] = v
+	}
+
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	next := &filterLogger{
+		next:      f.next.WithKeys(fields),
+		minLevel:  f.minLevel,
+		overrides: f.overrides,
+		fields:    merged,
+	}
+	next.allowed = next.effectiveLevel()
+
+	return next
+}
+
+// WithKey provides a new filter instance having specified key-value set.
+func (f *filterLogger) WithKey(key string, value any) Logger {
+	return f.WithKeys(Keys{Key(key): value})
+}
+
+// WithError provides a new filter instance having specified error key.
+func (f *filterLogger) WithError(err error) Logger {
+	return &filterLogger{
+		next:      f.next.WithError(err),
+		minLevel:  f.minLevel,
+		overrides: f.overrides,
+		fields:    f.fields,
+		allowed:   f.allowed,
+	}
+}
+
+// WithLevel provides a new filter instance inheriting settings from parent except the minimum level.
+func (f *filterLogger) WithLevel(level Level) Logger {
+	next := &filterLogger{
+		next:      f.next.WithLevel(level),
+		minLevel:  level,
+		overrides: f.overrides,
+		fields:    f.fields,
+	}
+	next.allowed = next.effectiveLevel()
+
+	return next
+}
+
+// WithContext takes data from specified context and applies it to the wrapped logger.
+func (f *filterLogger) WithContext(ctx context.Context) Logger {
+	return &filterLogger{
+		next:      f.next.WithContext(ctx),
+		minLevel:  f.minLevel,
+		overrides: f.overrides,
+		fields:    f.fields,
+		allowed:   f.allowed,
+	}
+}
+
+// InfoCtx sends info level data onto the wrapped logger, enriched with ctx, if allowed.
+func (f *filterLogger) InfoCtx(ctx context.Context, args ...interface{}) {
+	if f.IsEnabledForLevel(LevelInfo) {
+		f.next.WithContext(ctx).Info(args...)
+	}
+}
+
+// WithValues is an alias for WithContext.
+func (f *filterLogger) WithValues(ctx context.Context) Logger {
+	return f.WithContext(ctx)
+}
+
+// WithErrorCtx provides a new filter instance having specified error key and ctx data applied.
+func (f *filterLogger) WithErrorCtx(ctx context.Context, err error) Logger {
+	return &filterLogger{
+		next:      f.next.WithContext(ctx).WithError(err),
+		minLevel:  f.minLevel,
+		overrides: f.overrides,
+		fields:    f.fields,
+		allowed:   f.allowed,
+	}
+}
+
+// WithSamplingDisabled provides a new filter instance wrapping next with sampling disabled.
+func (f *filterLogger) WithSamplingDisabled() Logger {
+	return &filterLogger{
+		next:      f.next.WithSamplingDisabled(),
+		minLevel:  f.minLevel,
+		overrides: f.overrides,
+		fields:    f.fields,
+		allowed:   f.allowed,
+	}
+}
@@ -0,0 +1,78 @@
+package logging_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/logging"
+)
+
+func TestNewFilter(t *testing.T) {
+	backend := new(logging.Backend)
+
+	t.Run("default level matches AllowLevel", func(t *testing.T) {
+		filter := logging.NewFilter(backend.NewLogger(), logging.AllowLevel(logging.LevelWarn))
+		require.Equal(t, logging.LevelWarn, filter.Level())
+		require.False(t, filter.IsEnabledForLevel(logging.LevelInfo))
+		require.True(t, filter.IsEnabledForLevel(logging.LevelWarn))
+	})
+
+	t.Run("AllowAll permits everything", func(t *testing.T) {
+		filter := logging.NewFilter(backend.NewLogger(), logging.AllowAll())
+		require.True(t, filter.IsEnabledForLevel(logging.LevelTrace))
+	})
+
+	t.Run("AllowKey raises allowed level on matching field", func(t *testing.T) {
+		filter := logging.NewFilter(backend.NewLogger(),
+			logging.AllowLevel(logging.LevelInfo),
+			logging.AllowKey("module", "p2p", logging.LevelDebug),
+		)
+		require.Equal(t, logging.LevelInfo, filter.Level())
+
+		tagged := filter.WithKey("module", "p2p")
+		require.Equal(t, logging.LevelDebug, tagged.Level())
+
+		untagged := filter.WithKey("module", "rpc")
+		require.Equal(t, logging.LevelInfo, untagged.Level())
+	})
+
+	t.Run("filters compose", func(t *testing.T) {
+		inner := logging.NewFilter(backend.NewLogger(), logging.AllowLevel(logging.LevelDebug))
+		outer := logging.NewFilter(inner, logging.AllowLevel(logging.LevelWarn))
+		require.Equal(t, logging.LevelWarn, outer.Level())
+	})
+}
+
+func TestNewFilter_GatesError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "filtered.log")
+
+	config := logging.NewConfig()
+	config.Apply(
+		logging.WithTarget(logging.Target(path)),
+		logging.WithFormat(logging.FormatJSON),
+	)
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	filter := logging.NewFilter(backend.NewLogger(logging.LevelTrace), logging.AllowLevel(logging.LevelFatal))
+	require.False(t, filter.IsEnabledForLevel(logging.LevelError))
+
+	filter.Error("suppressed error")
+	filter.Errorf("suppressed %s", "errorf")
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Empty(t, content, "Error/Errorf above the filter's allowed level must not reach next")
+
+	allowed := logging.NewFilter(backend.NewLogger(logging.LevelTrace), logging.AllowLevel(logging.LevelError))
+	allowed.Error("permitted error")
+
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, strings.TrimSpace(string(content)), "permitted error")
+}
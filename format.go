@@ -9,11 +9,15 @@ const (
 	// FormatJSON defines constant value for format logging lines as JSON.
 	FormatJSON Format = "json"
 
+	// FormatLogfmt defines constant value for format logging lines as go-kit/logfmt-style
+	// key=value pairs.
+	FormatLogfmt Format = "logfmt"
+
 	// DefaultFormat defines default formatting if omitted.
 	DefaultFormat = FormatText
 )
 
-// Format defines output format. It should be either FormatText or FormatJSON
+// Format defines output format. It should be either FormatText, FormatJSON or FormatLogfmt.
 type Format string
 
 // String returns string representation of Format. Implements fmt.Stringer.
@@ -24,12 +28,10 @@ func (f Format) String() string {
 // Validate returns error if format value is not valid.
 func (f Format) Validate() error {
 	switch f {
-	case FormatText:
-		return nil
-	case FormatJSON:
+	case FormatText, FormatJSON, FormatLogfmt:
 		return nil
 	default:
-		return fmt.Errorf("%w: unexpected format `%v`, want `%s` or `%s", Error, f, FormatText, FormatJSON)
+		return fmt.Errorf("%w: unexpected format `%v`, want `%s`, `%s` or `%s`", Error, f, FormatText, FormatJSON, FormatLogfmt)
 	}
 }
 
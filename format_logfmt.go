@@ -0,0 +1,80 @@
+package logging
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// logfmtPriorityKeys defines the stable key order used when rendering a logfmt line: the well
+// known entry keys first, in this order, then every remaining key sorted alphabetically.
+var logfmtPriorityKeys = []string{
+	KeyTimestamp.String(),
+	KeyLevel.String(),
+	KeyLogger.String(),
+	KeyMessage.String(),
+	KeyCaller.String(),
+}
+
+// renderLogfmtFields renders fields as a single go-kit/logfmt-style line: logfmtPriorityKeys
+// first in that order (when present), then every remaining key sorted alphabetically. Shared by
+// the zap and zerolog logfmt encoders so both engines produce identically ordered, identically
+// escaped output.
+func renderLogfmtFields(fields map[string]any) string {
+	seen := make(map[string]bool, len(logfmtPriorityKeys))
+	parts := make([]string, 0, len(fields))
+
+	for _, key := range logfmtPriorityKeys {
+		if value, ok := fields[key]; ok {
+			seen[key] = true
+			parts = append(parts, key+"="+logfmtQuote(fmt.Sprint(value)))
+		}
+	}
+
+	rest := make([]string, 0, len(fields)-len(seen))
+	for key := range fields {
+		if !seen[key] {
+			rest = append(rest, key)
+		}
+	}
+
+	sort.Strings(rest)
+
+	for _, key := range rest {
+		parts = append(parts, key+"="+logfmtQuote(fmt.Sprint(fields[key])))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// logfmtQuote renders value per the go-kit/logfmt convention: the value is double-quote wrapped,
+// with internal quotes and backslashes backslash-escaped and newlines rendered as `\n`, whenever
+// it contains whitespace, '"', '=', a backslash or a newline - a record must never span more than
+// one line.
+func logfmtQuote(value string) string {
+	needsQuote := strings.ContainsAny(value, " \t\"=\n") || strings.Contains(value, `\`)
+	if !needsQuote {
+		return value
+	}
+
+	var b strings.Builder
+
+	b.WriteByte('"')
+
+	for _, r := range value {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	b.WriteByte('"')
+
+	return b.String()
+}
@@ -0,0 +1,40 @@
+package logging
+
+import "testing"
+
+func TestLogfmtQuote(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"plain", "hello", "hello"},
+		{"whitespace", "hello world", `"hello world"`},
+		{"equals", "a=b", `"a=b"`},
+		{"quote", `say "hi"`, `"say \"hi\""`},
+		{"backslash", `C:\path`, `"C:\\path"`},
+		{"newline", "line1\nline2", `"line1\nline2"`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := logfmtQuote(tt.value); got != tt.want {
+				t.Errorf("logfmtQuote(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderLogfmtFields(t *testing.T) {
+	fields := map[string]any{
+		KeyMessage.String():   "started",
+		KeyLevel.String():     "info",
+		KeyTimestamp.String(): "2026-07-26T00:00:00Z",
+		"zeta":                1,
+		"alpha":               "a b",
+	}
+
+	want := `ts=2026-07-26T00:00:00Z level=info msg=started alpha="a b" zeta=1`
+
+	if got := renderLogfmtFields(fields); got != want {
+		t.Errorf("renderLogfmtFields() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,37 @@
+package logging_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/logging"
+)
+
+func TestFormat_Validate(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		format  logging.Format
+		wantErr bool
+	}{
+		{"text", logging.FormatText, false},
+		{"json", logging.FormatJSON, false},
+		{"logfmt", logging.FormatLogfmt, false},
+		{"unknown", logging.Format("yaml"), true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.format.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWithFormat(t *testing.T) {
+	config := logging.NewConfig()
+	config.Apply(logging.WithFormat(logging.FormatLogfmt))
+	require.Equal(t, logging.FormatLogfmt, config.Format)
+}
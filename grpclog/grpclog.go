@@ -0,0 +1,111 @@
+// Package grpclog adapts a logging.Logger into grpc-go's grpclog.LoggerV2, letting gRPC library
+// logs flow through the same Backend, format and CustomLevels configuration as application logs.
+package grpclog
+
+import (
+	"google.golang.org/grpc/grpclog"
+
+	"github.com/amarin/logging"
+)
+
+// callerSkipper is optionally implemented by logging.Logger instances (currently only the zap
+// engine) that support pointing caller frames further up the stack. Adapter uses it, when present,
+// so a reported caller points at the gRPC call site rather than this adapter's own methods.
+type callerSkipper interface {
+	WithCallerSkip(skip int) logging.Logger
+}
+
+// Adapter wraps a logging.Logger to satisfy grpclog.LoggerV2.
+type Adapter struct {
+	logger logging.Logger
+}
+
+// NewAdapter wraps logger as a grpclog.LoggerV2. When logger supports it, an extra caller-skip
+// frame is applied so reported callers point at the gRPC call site rather than this adapter.
+func NewAdapter(logger logging.Logger) *Adapter {
+	if skipper, ok := logger.(callerSkipper); ok {
+		logger = skipper.WithCallerSkip(1)
+	}
+
+	return &Adapter{logger: logger}
+}
+
+// Install wraps logger with NewAdapter and installs it as grpc-go's package-wide logger via
+// grpclog.SetLoggerV2. Returns the installed Adapter.
+func Install(logger logging.Logger) *Adapter {
+	adapter := NewAdapter(logger)
+	grpclog.SetLoggerV2(adapter)
+
+	return adapter
+}
+
+// Info logs to INFO log.
+func (a *Adapter) Info(args ...any) {
+	a.logger.Info(args...)
+}
+
+// Infoln logs to INFO log.
+func (a *Adapter) Infoln(args ...any) {
+	a.logger.Info(args...)
+}
+
+// Infof logs to INFO log.
+func (a *Adapter) Infof(format string, args ...any) {
+	a.logger.Infof(format, args...)
+}
+
+// Warning logs to WARNING log.
+func (a *Adapter) Warning(args ...any) {
+	a.logger.Warn(args...)
+}
+
+// Warningln logs to WARNING log.
+func (a *Adapter) Warningln(args ...any) {
+	a.logger.Warn(args...)
+}
+
+// Warningf logs to WARNING log.
+func (a *Adapter) Warningf(format string, args ...any) {
+	a.logger.Warnf(format, args...)
+}
+
+// Error logs to ERROR log.
+func (a *Adapter) Error(args ...any) {
+	a.logger.Error(args...)
+}
+
+// Errorln logs to ERROR log.
+func (a *Adapter) Errorln(args ...any) {
+	a.logger.Error(args...)
+}
+
+// Errorf logs to ERROR log.
+func (a *Adapter) Errorf(format string, args ...any) {
+	a.logger.Errorf(format, args...)
+}
+
+// Fatal logs to ERROR log and calls os.Exit(1).
+func (a *Adapter) Fatal(args ...any) {
+	a.logger.Fatal(args...)
+}
+
+// Fatalln logs to ERROR log and calls os.Exit(1).
+func (a *Adapter) Fatalln(args ...any) {
+	a.logger.Fatal(args...)
+}
+
+// Fatalf logs to ERROR log and calls os.Exit(1).
+func (a *Adapter) Fatalf(format string, args ...any) {
+	a.logger.Fatalf(format, args...)
+}
+
+// V reports whether verbosity level l is enabled, mapping grpclog's 0 (info) and up scale onto
+// logging.Level: 0 maps to Info, anything more verbose maps to Debug, matching the mapping used by
+// other grpclog.LoggerV2 adapters built on levelled loggers.
+func (a *Adapter) V(l int) bool {
+	if l <= 0 {
+		return a.logger.IsEnabledForLevel(logging.LevelInfo)
+	}
+
+	return a.logger.IsEnabledForLevel(logging.LevelDebug)
+}
@@ -0,0 +1,54 @@
+package grpclog_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/logging"
+	"github.com/amarin/logging/grpclog"
+)
+
+func TestAdapter_DelegatesToLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "grpc.log")
+
+	config := logging.NewConfig()
+	config.Apply(logging.WithTarget(logging.Target(path)), logging.WithFormat(logging.FormatJSON))
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	adapter := grpclog.NewAdapter(backend.NewLogger(logging.LevelDebug))
+	adapter.Info("connected")
+	adapter.Warningf("retrying %d", 3)
+	adapter.Error("dial failed")
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "connected")
+	require.Contains(t, string(content), "retrying 3")
+	require.Contains(t, string(content), "dial failed")
+}
+
+func TestAdapter_V(t *testing.T) {
+	config := logging.NewConfig()
+	config.Apply(logging.WithLevel(logging.LevelInfo))
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	adapter := grpclog.NewAdapter(backend.NewLogger(logging.LevelInfo))
+
+	require.True(t, adapter.V(0))
+	require.False(t, adapter.V(2))
+}
+
+func TestAdapter_Install(t *testing.T) {
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*logging.NewConfig()))
+
+	adapter := grpclog.Install(backend.NewLogger(logging.LevelInfo))
+	require.NotNil(t, adapter)
+}
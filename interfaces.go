@@ -62,4 +62,30 @@ type Logger interface {
 
 	// WithContext takes data from specified context. Uses configured ContextExtractorFunc's.
 	WithContext(ctx context.Context) Logger
+
+	// WithValues is an alias for WithContext, named to match the context-logger propagation
+	// convention used by other logging packages.
+	WithValues(ctx context.Context) Logger
+
+	// InfoCtx sends info level data onto logging, first enriching the logger with ctx via WithContext.
+	InfoCtx(ctx context.Context, args ...interface{})
+
+	// WithErrorCtx provides a new logger instance having specified error key and ctx data applied.
+	WithErrorCtx(ctx context.Context, err error) Logger
+
+	// WithSamplingDisabled returns a logger instance that bypasses sampling configured with
+	// WithSampling, for audit-critical call sites that must never drop a record. A no-op on loggers
+	// that are not sampled.
+	WithSamplingDisabled() Logger
+}
+
+// Provider abstracts the underlying logging engine used by Backend to build Logger instances.
+// It keeps Backend and the public Logger surface engine-agnostic so alternative logging
+// libraries can be selected via Config.Engine without touching calling code.
+type Provider interface {
+	// NewLogger returns a Logger built from config, starting at the given level.
+	NewLogger(config Config, level Level) (Logger, error)
+
+	// Sync flushes any buffered log entries held by the provider.
+	Sync() error
 }
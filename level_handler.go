@@ -0,0 +1,91 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// levelsResponse is the JSON payload returned by LevelHandler on GET.
+type levelsResponse struct {
+	Root  Level            `json:"root"`
+	Named map[string]Level `json:"named"`
+}
+
+// setLevelRequest is the JSON payload accepted by LevelHandler on PUT/POST.
+type setLevelRequest struct {
+	Name  string `json:"name"`
+	Level Level  `json:"level"`
+}
+
+// LevelHandler returns an http.Handler exposing the global backend's root and named logger
+// levels, in the {"root":"info","named":{"app":"debug"}} shape used by Vault's sys/loggers
+// endpoint.
+//
+// GET returns the root Config.Level plus the live level of every named Logger vended so far.
+//
+// PUT or POST with body {"name":"app","level":"trace"} applies level to the named logger,
+// reconfiguring in place any Logger already vended by NewNamedLogger so existing loggers, not
+// just future ones, honor the change. An empty or "root" name instead updates the root level via
+// Backend.SetLevel, which describes how that reaches already-vended loggers.
+//
+// The returned handler panics on a request if global logging.Init has not been called,
+// consistent with the package's other top-level constructors.
+//
+// Deprecated: covers the same ground as Backend.AdminHandler, which exposes root and per-logger
+// levels over a path-based REST shape instead of this single-endpoint {"name",...} body. Prefer
+// AdminHandler in new code; this is kept for callers already depending on its wire shape. Both
+// read the root level through Backend.RootLevel and write through Backend.SetLevel, but the
+// listings differ: GET here reports the live level of every vended named Logger
+// (Backend.NamedLevels), while AdminHandler's GET reports the CustomLevels entries
+// (Backend.CustomLevels).
+func LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		backend := usingBackend
+		initialized := initDone
+		mu.Unlock()
+
+		switch {
+		case backend == nil:
+			panic(fmt.Errorf("%w: set backend first", Error))
+		case !initialized:
+			panic(fmt.Errorf("%w: init first", Error))
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			backend.handleLevels(w)
+		case http.MethodPut, http.MethodPost:
+			backend.handleSetLevel(w, r)
+		default:
+			http.Error(w, fmt.Sprintf("%v: unsupported %s %s", Error, r.Method, r.URL.Path), http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// handleLevels writes the root level and every named logger's live level as JSON.
+func (backend *Backend) handleLevels(w http.ResponseWriter) {
+	resp := levelsResponse{
+		Root:  backend.RootLevel(),
+		Named: backend.NamedLevels(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp) //nolint:errcheck
+}
+
+// handleSetLevel decodes the request body and applies the requested level to req.Name, or to the
+// root Config.Level when Name is empty or "root".
+func (backend *Backend) handleSetLevel(w http.ResponseWriter, r *http.Request) {
+	var req setLevelRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("%v: decode request: %v", Error, err), http.StatusBadRequest)
+		return
+	}
+
+	backend.SetLevel(req.Name, req.Level)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,66 @@
+package logging_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/logging"
+)
+
+func TestLevelHandler(t *testing.T) {
+	require.NoError(t, logging.Init())
+
+	handler := logging.LevelHandler()
+
+	logger := logging.NewNamedLogger("level-handler-test")
+	require.Equal(t, logging.DefaultLevel, logger.Level())
+
+	t.Run("get reports root and named levels", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var body struct {
+			Root  logging.Level            `json:"root"`
+			Named map[string]logging.Level `json:"named"`
+		}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		require.Equal(t, logging.DefaultLevel, body.Root)
+		require.Equal(t, logging.DefaultLevel, body.Named["level-handler-test"])
+	})
+
+	t.Run("put sets named logger level in place", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		body := strings.NewReader(`{"name":"level-handler-test","level":"trace"}`)
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPut, "/", body))
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		require.Equal(t, logging.LevelTrace, logger.Level())
+	})
+
+	t.Run("post with empty name sets root level", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		body := strings.NewReader(`{"level":"warn"}`)
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/", body))
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		listed := httptest.NewRecorder()
+		handler.ServeHTTP(listed, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		var got struct {
+			Root logging.Level `json:"root"`
+		}
+		require.NoError(t, json.NewDecoder(listed.Body).Decode(&got))
+		require.Equal(t, logging.LevelWarn, got.Root)
+	})
+
+	t.Run("unsupported method", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/", nil))
+		require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
@@ -0,0 +1,76 @@
+package logging
+
+import (
+	"go.uber.org/zap/buffer"
+	"go.uber.org/zap/zapcore"
+)
+
+// logfmtBufferPool hands out reusable buffers for zapLogfmtEncoder.EncodeEntry, mirroring the pool
+// zapcore's own encoders keep internally.
+var logfmtBufferPool = buffer.NewPool()
+
+// zapLogfmtEncoder implements zapcore.Encoder, rendering each entry as a logfmt line using
+// renderLogfmtFields so its key ordering and escaping match the zerolog engine exactly.
+type zapLogfmtEncoder struct {
+	*zapcore.MapObjectEncoder
+	cfg zapcore.EncoderConfig
+}
+
+// newLogfmtEncoder builds a zapcore.Encoder producing logfmt output, using cfg's key names.
+func newLogfmtEncoder(cfg zapcore.EncoderConfig) zapcore.Encoder {
+	return &zapLogfmtEncoder{MapObjectEncoder: zapcore.NewMapObjectEncoder(), cfg: cfg}
+}
+
+// Clone returns a copy of enc carrying the same accumulated With() fields.
+func (enc *zapLogfmtEncoder) Clone() zapcore.Encoder {
+	clone := &zapLogfmtEncoder{MapObjectEncoder: zapcore.NewMapObjectEncoder(), cfg: enc.cfg}
+
+	for k, v := range enc.Fields {
+		clone.Fields[k] = v
+	}
+
+	return clone
+}
+
+// EncodeEntry renders entry and fields as a single logfmt line.
+func (enc *zapLogfmtEncoder) EncodeEntry(entry zapcore.Entry, fields []zapcore.Field) (*buffer.Buffer, error) {
+	values := zapcore.NewMapObjectEncoder()
+
+	for k, v := range enc.Fields {
+		values.Fields[k] = v
+	}
+
+	for _, f := range fields {
+		f.AddTo(values)
+	}
+
+	if enc.cfg.TimeKey != "" {
+		values.Fields[enc.cfg.TimeKey] = entry.Time.Format(TimestampFormatConsole)
+	}
+
+	if enc.cfg.LevelKey != "" {
+		values.Fields[enc.cfg.LevelKey] = entry.Level.String()
+	}
+
+	if enc.cfg.NameKey != "" && entry.LoggerName != "" {
+		values.Fields[enc.cfg.NameKey] = entry.LoggerName
+	}
+
+	if enc.cfg.MessageKey != "" {
+		values.Fields[enc.cfg.MessageKey] = entry.Message
+	}
+
+	if enc.cfg.CallerKey != "" && entry.Caller.Defined {
+		values.Fields[enc.cfg.CallerKey] = entry.Caller.TrimmedPath()
+	}
+
+	if enc.cfg.StacktraceKey != "" && entry.Stack != "" {
+		values.Fields[enc.cfg.StacktraceKey] = entry.Stack
+	}
+
+	line := logfmtBufferPool.Get()
+	line.AppendString(renderLogfmtFields(values.Fields))
+	line.AppendString(enc.cfg.LineEnding)
+
+	return line, nil
+}
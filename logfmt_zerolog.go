@@ -0,0 +1,27 @@
+package logging
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// logfmtWriter wraps an io.Writer, re-encoding each zerolog JSON line written to it as a logfmt
+// line via renderLogfmtFields, so the zap and zerolog engines share identical key ordering and
+// escaping for FormatLogfmt.
+type logfmtWriter struct {
+	out io.Writer
+}
+
+// Write decodes p as a single zerolog JSON record and writes its logfmt rendering to out.
+func (w *logfmtWriter) Write(p []byte) (int, error) {
+	fields := make(map[string]any)
+	if err := json.Unmarshal(p, &fields); err != nil {
+		return w.out.Write(p)
+	}
+
+	if _, err := io.WriteString(w.out, renderLogfmtFields(fields)+"\n"); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
@@ -1,13 +1,18 @@
 package logging
 
 import (
+	"context"
+
 	"go.uber.org/zap"
 )
 
 // Logger wraps zap.SugaredLogger to hide zap requirements.
 type zapLogger struct {
 	*zap.SugaredLogger
-	level Level
+	level     Level
+	provider  *zapProvider // source provider, used by WithLevel/setLevel to reuse its per-Level core cache
+	fields    []any        // accumulated WithKeys/WithKey/WithError fields, replayed when level changes
+	hasCaller bool         // tracks whether zap.AddCaller was already applied, so WithError doesn't stack it
 }
 
 // Level returns current logger level.
@@ -16,16 +21,48 @@ func (logger zapLogger) Level() Level {
 }
 
 // WithLevel returns a copy of logger with requested logging.Level set.
+// When the logger has a provider, it reuses the provider's cached per-Level core instead of
+// Desugar/WithOptions-cloning the current chain on every call.
 func (logger zapLogger) WithLevel(level Level) Logger {
 	logger.level = level
 
-	if !logger.IsEnabledForLevel(level) {
+	switch {
+	case logger.provider != nil:
+		logger.SugaredLogger = logger.provider.leveledLogger(level).Sugar().With(logger.fields...)
+		logger.hasCaller = false // rebuilt from the provider core, without WithError's AddCaller
+	case !logger.IsEnabledForLevel(level):
 		logger.SugaredLogger = logger.SugaredLogger.Desugar().WithOptions(zap.IncreaseLevel(zapLevel(level))).Sugar()
 	}
 
 	return logger
 }
 
+// named returns a copy of logger with the given name attached, implementing namedLogger.
+func (logger zapLogger) named(name string) Logger {
+	return &zapLogger{
+		SugaredLogger: logger.SugaredLogger.Named(name),
+		level:         logger.level,
+		provider:      logger.provider,
+		fields:        logger.fields,
+		hasCaller:     logger.hasCaller,
+	}
+}
+
+// setLevel mutates the logger's level in place.
+// Used by Backend to propagate runtime level changes to already-vended named loggers
+// without requiring callers to re-fetch them.
+func (logger *zapLogger) setLevel(level Level) {
+	logger.level = level
+
+	switch {
+	case logger.provider != nil:
+		logger.SugaredLogger = logger.provider.leveledLogger(level).Sugar().With(logger.fields...)
+		logger.hasCaller = false // rebuilt from the provider core, without WithError's AddCaller
+	case !logger.IsEnabledForLevel(level):
+		logger.SugaredLogger = logger.SugaredLogger.Desugar().WithOptions(zap.IncreaseLevel(zapLevel(level))).Sugar()
+	}
+}
+
 // IsEnabledForLevel detects if internal logging level suitable to produce messages with specified logging.Level.
 // Used to filter messages in Trace, Debug, Info, Warn, Error
 // and formatting method companions Tracef, Debugf, Infof, Warnf, Errorf.
@@ -121,6 +158,9 @@ func (logger zapLogger) WithKeys(fields Keys) Logger {
 	return &zapLogger{
 		SugaredLogger: logger.SugaredLogger.With(zapFields...),
 		level:         logger.level,
+		provider:      logger.provider,
+		fields:        append(append([]any{}, logger.fields...), zapFields...),
+		hasCaller:     logger.hasCaller,
 	}
 }
 
@@ -131,15 +171,66 @@ func (logger zapLogger) WithKey(key string, value any) Logger {
 	return &zapLogger{
 		SugaredLogger: logger.SugaredLogger.With(zapField),
 		level:         logger.level,
+		provider:      logger.provider,
+		fields:        append(append([]any{}, logger.fields...), zapField),
+		hasCaller:     logger.hasCaller,
 	}
 }
 
 // WithError provides a new logger instance having specified error key.
+// Only applies zap.AddCaller once per chain instead of re-stacking it on every repeated call.
 func (logger zapLogger) WithError(err error) Logger {
 	zapField := zap.Error(err)
+	sugared := logger.SugaredLogger
+
+	if !logger.hasCaller {
+		sugared = sugared.WithOptions(zap.AddCaller())
+	}
+
+	return &zapLogger{
+		SugaredLogger: sugared.With(zapField),
+		level:         logger.level,
+		provider:      logger.provider,
+		fields:        append(append([]any{}, logger.fields...), zapField),
+		hasCaller:     true,
+	}
+}
+
+// WithContext takes data from specified context. Uses configured ContextExtractorFunc's.
+func (logger zapLogger) WithContext(ctx context.Context) Logger {
+	return logger.WithKeys(CurrentConfig().contextKeys(ctx))
+}
+
+// InfoCtx sends info level data onto logging, first enriching the logger with ctx via WithContext.
+func (logger zapLogger) InfoCtx(ctx context.Context, args ...interface{}) {
+	logger.WithContext(ctx).Info(args...)
+}
+
+// WithValues is an alias for WithContext.
+func (logger zapLogger) WithValues(ctx context.Context) Logger {
+	return logger.WithContext(ctx)
+}
+
+// WithErrorCtx provides a new logger instance having specified error key and ctx data applied.
+func (logger zapLogger) WithErrorCtx(ctx context.Context, err error) Logger {
+	return logger.WithContext(ctx).WithError(err)
+}
+
+// WithSamplingDisabled returns logger unchanged: zapLogger is never itself sampled, only the
+// samplingLogger wrapper Backend inserts above it is.
+func (logger zapLogger) WithSamplingDisabled() Logger {
+	return logger
+}
 
+// WithCallerSkip returns a new logger instance that skips an additional skip stack frames when
+// reporting the caller, on top of the base skip Backend already applies. Intended for adapters
+// that log on behalf of a caller further up the stack, e.g. logging/grpclog.
+func (logger zapLogger) WithCallerSkip(skip int) Logger {
 	return &zapLogger{
-		SugaredLogger: logger.SugaredLogger.WithOptions(zap.AddCaller()).With(zapField),
+		SugaredLogger: logger.SugaredLogger.WithOptions(zap.AddCallerSkip(skip)),
 		level:         logger.level,
+		provider:      logger.provider,
+		fields:        logger.fields,
+		hasCaller:     logger.hasCaller,
 	}
 }
@@ -0,0 +1,157 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+)
+
+// zerologLogger wraps zerolog.Logger to fulfil the Logger interface.
+type zerologLogger struct {
+	logger zerolog.Logger
+	level  Level
+}
+
+// Level returns current logger level.
+func (logger zerologLogger) Level() Level {
+	return logger.level
+}
+
+// WithLevel returns a copy of logger with requested logging.Level set.
+func (logger zerologLogger) WithLevel(level Level) Logger {
+	logger.level = level
+	logger.logger = logger.logger.Level(zerologLevel(level))
+
+	return logger
+}
+
+// setLevel mutates the logger's level in place, implementing levelSetter.
+func (logger *zerologLogger) setLevel(level Level) {
+	logger.level = level
+	logger.logger = logger.logger.Level(zerologLevel(level))
+}
+
+// named returns a copy of logger with the given name attached, implementing namedLogger.
+func (logger zerologLogger) named(name string) Logger {
+	return &zerologLogger{
+		logger: logger.logger.With().Str(KeyLogger.String(), name).Logger(),
+		level:  logger.level,
+	}
+}
+
+// IsEnabledForLevel detects if internal logging level suitable to produce messages with specified logging.Level.
+func (logger zerologLogger) IsEnabledForLevel(level Level) bool {
+	return logger.level.IsEnabledForLevel(level)
+}
+
+// Sync is a no-op: zerolog writes synchronously through the underlying io.Writer.
+func (logger zerologLogger) Sync() error {
+	return nil
+}
+
+// Trace sends trace data onto logging.
+func (logger zerologLogger) Trace(args ...interface{}) {
+	logger.logger.Trace().Msg(fmt.Sprint(args...))
+}
+
+// Tracef sends message template and filling arguments onto logging.
+func (logger zerologLogger) Tracef(format string, args ...interface{}) {
+	logger.logger.Trace().Msg(fmt.Sprintf(format, args...))
+}
+
+// Debug sends debug data onto logging.
+func (logger zerologLogger) Debug(args ...interface{}) {
+	logger.logger.Debug().Msg(fmt.Sprint(args...))
+}
+
+// Debugf sends message template and filling arguments onto logging.
+func (logger zerologLogger) Debugf(format string, args ...interface{}) {
+	logger.logger.Debug().Msg(fmt.Sprintf(format, args...))
+}
+
+// Info sends info level data onto logging.
+func (logger zerologLogger) Info(args ...interface{}) {
+	logger.logger.Info().Msg(fmt.Sprint(args...))
+}
+
+// Infof sends message template and filling arguments onto logging.
+func (logger zerologLogger) Infof(format string, args ...interface{}) {
+	logger.logger.Info().Msg(fmt.Sprintf(format, args...))
+}
+
+// Warn sends warn data onto logging.
+func (logger zerologLogger) Warn(args ...interface{}) {
+	logger.logger.Warn().Msg(fmt.Sprint(args...))
+}
+
+// Warnf sends message template and filling arguments onto logging.
+func (logger zerologLogger) Warnf(format string, args ...interface{}) {
+	logger.logger.Warn().Msg(fmt.Sprintf(format, args...))
+}
+
+// Error sends error data onto logging.
+func (logger zerologLogger) Error(args ...interface{}) {
+	logger.logger.Error().Msg(fmt.Sprint(args...))
+}
+
+// Errorf sends message template and filling arguments onto logging.
+func (logger zerologLogger) Errorf(format string, args ...interface{}) {
+	logger.logger.Error().Msg(fmt.Sprintf(format, args...))
+}
+
+// Fatal sends error data onto logging and calls os.exit(1).
+func (logger zerologLogger) Fatal(args ...interface{}) {
+	logger.logger.Fatal().Msg(fmt.Sprint(args...))
+}
+
+// Fatalf sends message template and filling arguments onto logging and calls os.exit(1).
+func (logger zerologLogger) Fatalf(format string, args ...interface{}) {
+	logger.logger.Fatal().Msg(fmt.Sprintf(format, args...))
+}
+
+// WithKeys provides a new logger instance having specified key-value set.
+func (logger zerologLogger) WithKeys(fields Keys) Logger {
+	ctx := logger.logger.With()
+	for k, v := range fields {
+		ctx = ctx.Interface(k.String(), v)
+	}
+
+	return &zerologLogger{logger: ctx.Logger(), level: logger.level}
+}
+
+// WithKey provides a new logger instance having specified key-value set.
+func (logger zerologLogger) WithKey(key string, value any) Logger {
+	return &zerologLogger{logger: logger.logger.With().Interface(key, value).Logger(), level: logger.level}
+}
+
+// WithError provides a new logger instance having specified error key.
+func (logger zerologLogger) WithError(err error) Logger {
+	return &zerologLogger{logger: logger.logger.With().Err(err).Logger(), level: logger.level}
+}
+
+// WithContext takes data from specified context. Uses configured ContextExtractorFunc's.
+func (logger zerologLogger) WithContext(ctx context.Context) Logger {
+	return logger.WithKeys(CurrentConfig().contextKeys(ctx))
+}
+
+// InfoCtx sends info level data onto logging, first enriching the logger with ctx via WithContext.
+func (logger zerologLogger) InfoCtx(ctx context.Context, args ...interface{}) {
+	logger.WithContext(ctx).Info(args...)
+}
+
+// WithValues is an alias for WithContext.
+func (logger zerologLogger) WithValues(ctx context.Context) Logger {
+	return logger.WithContext(ctx)
+}
+
+// WithErrorCtx provides a new logger instance having specified error key and ctx data applied.
+func (logger zerologLogger) WithErrorCtx(ctx context.Context, err error) Logger {
+	return logger.WithContext(ctx).WithError(err)
+}
+
+// WithSamplingDisabled returns logger unchanged: zerologLogger is never itself sampled, only the
+// samplingLogger wrapper Backend inserts above it is.
+func (logger zerologLogger) WithSamplingDisabled() Logger {
+	return logger
+}
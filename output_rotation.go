@@ -0,0 +1,99 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// RotationConfig configures size/age/backup-based rotation for a file Target via
+// gopkg.in/natefinch/lumberjack.v2. See WithRotation.
+type RotationConfig struct {
+	// MaxSize is the maximum size in megabytes of the log file before it gets rotated.
+	MaxSize int `yaml:"maxSize,omitempty"`
+	// MaxAge is the maximum number of days to retain old rotated files, based on the timestamp
+	// encoded in their name.
+	MaxAge int `yaml:"maxAge,omitempty"`
+	// MaxBackups is the maximum number of old rotated files to retain. Old files beyond this are
+	// deleted during rotation.
+	MaxBackups int `yaml:"maxBackups,omitempty"`
+	// Compress determines whether rotated files are gzip-compressed.
+	Compress bool `yaml:"compress,omitempty"`
+	// LocalTime determines whether the timestamp encoded in rotated file names uses the host's
+	// local time instead of UTC.
+	LocalTime bool `yaml:"localTime,omitempty"`
+}
+
+// WithRotation enables lumberjack-based rotation for the file output at target, applied when
+// provider construction opens that target (via RotatingOutput/AsyncRotatingOutput, see
+// resolveWriteSyncer); a target already opened without rotation keeps its existing writer. A
+// no-op for the StdOut, StdErr and SysLog targets, which lumberjack cannot rotate.
+func WithRotation(target Target, rotation RotationConfig) Option {
+	return func(config *Config) {
+		switch target {
+		case StdOut, StdErr, SysLog:
+			return
+		}
+
+		if config.Rotation == nil {
+			config.Rotation = make(map[Target]RotationConfig)
+		}
+
+		config.Rotation[target] = rotation
+	}
+}
+
+// Rotator is implemented by writers that support manual rotation, e.g. *lumberjack.Logger opened
+// through RotatingOutput/WithRotation. Backend.Rotate uses it to trigger rotation on demand,
+// typically from a SIGHUP handler.
+type Rotator interface {
+	Rotate() error
+}
+
+// RotatingOutput returns a *LockedWriter wrapping a *lumberjack.Logger configured per rotation for
+// output, or the writer already registered for output if one was opened previously.
+func RotatingOutput(output string, rotation RotationConfig) (*LockedWriter, error) {
+	return writers.registeredRotatingOutput(output, rotation)
+}
+
+// AsyncRotatingOutput combines RotatingOutput with AsyncWriter buffering, mirroring how AsyncOutput
+// layers onto the plain (non-rotating) Output.
+func AsyncRotatingOutput(output string, rotation RotationConfig, size int, policy OverflowPolicy) (*AsyncWriter, error) {
+	writer, err := writers.registeredAsyncRotatingOutput(output, rotation, size, policy)
+	if err != nil {
+		return nil, fmt.Errorf("%w: async rotating output: %v", Error, err)
+	}
+
+	return writer, nil
+}
+
+// resolveWriteSyncer opens target per base's AsyncBufferSize and Rotation settings, picking among
+// Output, AsyncOutput, RotatingOutput and AsyncRotatingOutput. Shared by the zap and zerolog
+// providers so both engines honor the same async/rotation configuration for a given Target.
+func resolveWriteSyncer(base Config, target Target) (WriteSyncer, error) {
+	rotation, rotating := base.Rotation[target]
+
+	switch {
+	case base.AsyncBufferSize > 0 && rotating:
+		return AsyncRotatingOutput(target.String(), rotation, base.AsyncBufferSize, base.AsyncOverflowPolicy)
+	case base.AsyncBufferSize > 0:
+		return AsyncOutput(target.String(), base.AsyncBufferSize, base.AsyncOverflowPolicy)
+	case rotating:
+		return RotatingOutput(target.String(), rotation)
+	default:
+		return Output(target.String())
+	}
+}
+
+// newLumberjackWriter builds the *lumberjack.Logger backing a rotating output for target.
+func newLumberjackWriter(target Target, rotation RotationConfig) io.Writer {
+	return &lumberjack.Logger{
+		Filename:   target.String(),
+		MaxSize:    rotation.MaxSize,
+		MaxAge:     rotation.MaxAge,
+		MaxBackups: rotation.MaxBackups,
+		Compress:   rotation.Compress,
+		LocalTime:  rotation.LocalTime,
+	}
+}
@@ -0,0 +1,65 @@
+package logging_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/logging"
+)
+
+func TestBackend_WithRotation_WritesAndRotate(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rotating.log")
+
+	config := logging.NewConfig()
+	config.Apply(
+		logging.WithTarget(logging.Target(path)),
+		logging.WithRotation(logging.Target(path), logging.RotationConfig{MaxBackups: 1}),
+	)
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	backend.NewLogger(logging.LevelInfo).Info("before rotation")
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "before rotation")
+
+	require.NoError(t, backend.Rotate())
+
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(content), "before rotation", "rotation should have started a fresh file")
+
+	matches, err := filepath.Glob(filepath.Join(dir, "rotating-*.log"))
+	require.NoError(t, err)
+	require.NotEmpty(t, matches, "expected a rotated backup file next to %s", path)
+
+	backend.NewLogger(logging.LevelInfo).Info("after rotation")
+
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "after rotation")
+
+	require.NoError(t, backend.Close())
+}
+
+func TestBackend_Rotate_NoOpForPlainTarget(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plain.log")
+
+	config := logging.NewConfig()
+	config.Apply(logging.WithTarget(logging.Target(path)))
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	backend.NewLogger(logging.LevelInfo).Info("hello")
+	require.NoError(t, backend.Rotate())
+
+	_, err := os.Stat(path)
+	require.NoError(t, err, "plain (non-rotating) target must not be moved aside by Rotate")
+}
@@ -0,0 +1,262 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/imperfectgo/zap-syslog"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/imperfectgo/zap-syslog/syslog"
+)
+
+// zapProvider implements Provider using go.uber.org/zap. It is the DefaultEngine.
+type zapProvider struct {
+	core      *zap.Logger
+	baseLevel Level // level core was built at; IncreaseLevel can only raise strictness above this
+
+	// rootLevel backs the enabler of every writer core built at the root Config.Level (the common
+	// case: WriterConfig.Level == Config.Level), letting setRootLevel change their effective
+	// strictness live. Writers configured with an explicit, different Level (see WithEventWriter)
+	// keep their own fixed enabler instead, preserving per-writer granularity.
+	rootLevel zap.AtomicLevel
+
+	mu      sync.Mutex
+	leveled map[Level]*zap.Logger // caches core.WithOptions(zap.IncreaseLevel(...)) per Level
+}
+
+// newZapProvider builds a zapProvider core from config, tee-ing one zapcore.Core per entry in
+// config.effectiveWriters so a single logger call reaches every target whose own level allows it.
+func newZapProvider(config Config) (*zapProvider, error) {
+	writerConfigs := config.effectiveWriters()
+	rootLevel := zap.NewAtomicLevelAt(zapLevel(config.Level))
+
+	cores := make([]zapcore.Core, 0, len(writerConfigs))
+
+	for _, writerConfig := range writerConfigs {
+		core, err := buildZapCore(config, writerConfig, rootLevel)
+		if err != nil {
+			return nil, err
+		}
+
+		cores = append(cores, core)
+	}
+
+	core := zapcore.NewTee(cores...)
+
+	return &zapProvider{
+		core:      zap.New(core).WithOptions(zapOptions(config)...),
+		baseLevel: config.Level,
+		rootLevel: rootLevel,
+	}, nil
+}
+
+// buildZapCore builds the zapcore.Core for a single WriterConfig entry, reusing zapEncoder/Output
+// with Format/Output overridden to the writer's own settings. writerConfig entries left at the root
+// Config.Level share rootLevel as their enabler so SetLevel can adjust them live; entries with an
+// explicit, different Level keep a fixed enabler instead.
+func buildZapCore(base Config, writerConfig WriterConfig, rootLevel zap.AtomicLevel) (zapcore.Core, error) {
+	var (
+		syncer zapcore.WriteSyncer
+		err    error
+	)
+
+	encoderConfig := base
+	encoderConfig.Format = writerConfig.Format
+	encoderConfig.Output = writerConfig.Target
+
+	encoder, err := zapEncoder(encoderConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if syncer, err = resolveWriteSyncer(base, writerConfig.Target); err != nil {
+		return nil, err
+	}
+
+	var enabler zapcore.LevelEnabler = zapLevel(writerConfig.Level)
+	if writerConfig.Level == base.Level {
+		enabler = rootLevel
+	}
+
+	core := zapcore.NewCore(encoder, syncer, enabler)
+
+	if writerConfig.StacktraceLevel > LevelTrace {
+		core = &stacktraceCore{Core: core, level: zapLevel(writerConfig.StacktraceLevel)}
+	}
+
+	return core, nil
+}
+
+// setRootLevel mutates the live level enabler shared by every writer core built at the root
+// Config.Level, implementing rootLeveler. Writers configured with their own explicit Level are
+// unaffected.
+func (provider *zapProvider) setRootLevel(level Level) {
+	provider.rootLevel.SetLevel(zapLevel(level))
+}
+
+// NewLogger returns a Logger wrapping zap's SugaredLogger at the given level.
+func (provider *zapProvider) NewLogger(_ Config, level Level) (Logger, error) {
+	return &zapLogger{SugaredLogger: provider.leveledLogger(level).Sugar(), level: level, provider: provider}, nil
+}
+
+// Sync flushes any buffered log entries held by the underlying zap core.
+func (provider *zapProvider) Sync() error {
+	return provider.core.Sync()
+}
+
+// leveledLogger returns the *zap.Logger wrapping provider.core with its level increased to level,
+// building it once per distinct Level and reusing it on subsequent calls. This avoids repeating the
+// Desugar/WithOptions clone on every WithLevel call made against loggers vended at the same level.
+// zap.IncreaseLevel can only raise a core's strictness, never lower it, so levels at or below
+// baseLevel are served directly from provider.core without wrapping.
+func (provider *zapProvider) leveledLogger(level Level) *zap.Logger {
+	if level <= provider.baseLevel {
+		return provider.core
+	}
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if provider.leveled == nil {
+		provider.leveled = make(map[Level]*zap.Logger)
+	}
+
+	if cached, ok := provider.leveled[level]; ok {
+		return cached
+	}
+
+	leveled := provider.core.WithOptions(zap.IncreaseLevel(zapLevel(level)))
+	provider.leveled[level] = leveled
+
+	return leveled
+}
+
+// zapEncoder makes a zapcore.Encoder for zapcore configuration.
+func zapEncoder(config Config) (zapcore.Encoder, error) {
+	encoderConfig := zapEncoderConfig(config)
+
+	if config.Output == SysLog {
+		syslogEncoderConfig := zapsyslog.SyslogEncoderConfig{
+			EncoderConfig: encoderConfig,
+			Facility:      syslog.LOG_DEBUG,
+			Hostname:      "localhost",
+			PID:           os.Getpid(),
+			App:           os.Args[0],
+		}
+		return zapsyslog.NewSyslogEncoder(syslogEncoderConfig), nil
+	}
+
+	switch config.Format {
+	case FormatText:
+		return zapcore.NewConsoleEncoder(encoderConfig), nil
+	case FormatJSON:
+		return zapcore.NewJSONEncoder(encoderConfig), nil
+	case FormatLogfmt:
+		return newLogfmtEncoder(encoderConfig), nil
+	default:
+		return nil, fmt.Errorf("%w: unknown format: %v", Error, config.Format)
+	}
+}
+
+// zapOptions builds the zap.Option set for config.
+func zapOptions(config Config) []zap.Option {
+	options := make([]zap.Option, 0)
+	if config.Output == StdOut { // addEntry stacktrace only for console
+		options = append(options, zap.AddStacktrace(zapcore.FatalLevel))
+	}
+
+	if config.Level == LevelDebug || config.Level == LevelTrace {
+		options = append(options, zap.AddCaller())
+	}
+
+	options = append(options, zap.AddCallerSkip(1)) // increase caller frame distance as using per-logger level
+
+	return options
+}
+
+// zapEncoderConfig builds the zapcore.EncoderConfig for config.
+func zapEncoderConfig(config Config) zapcore.EncoderConfig {
+	var timeEncoder zapcore.TimeEncoder
+
+	switch {
+	case config.Output == SysLog:
+		timeEncoder = zapcore.EpochTimeEncoder
+	case config.Format == FormatText:
+		timeEncoder = func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
+			enc.AppendString(t.Format(TimestampFormatConsole))
+		}
+	default: // assume JSON encode
+		timeEncoder = func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
+			enc.AppendString(t.Format(TimestampFormatJSON))
+		}
+	}
+
+	encoderConfig := zapcore.EncoderConfig{ //nolint:exhaustivestruct
+		TimeKey:          KeyTimestamp.String(),
+		LevelKey:         KeyLevel.String(),
+		NameKey:          KeyLogger.String(),
+		CallerKey:        KeyCaller.String(),
+		FunctionKey:      zapcore.OmitKey,
+		MessageKey:       KeyMessage.String(),
+		StacktraceKey:    KeyStackTrace.String(),
+		LineEnding:       zapcore.DefaultLineEnding,
+		EncodeLevel:      zapcore.LowercaseLevelEncoder,
+		EncodeDuration:   zapcore.SecondsDurationEncoder,
+		EncodeCaller:     zapcore.ShortCallerEncoder,
+		EncodeTime:       timeEncoder,
+		ConsoleSeparator: " ",
+	}
+
+	return encoderConfig
+}
+
+// zapLevel maps logging Level to underlying zapcore.Level.
+// NOTE: LevelTrace has no direct mapping onto zap logging level and mapped to zapcore.DebugLevel.
+func zapLevel(l Level) zapcore.Level {
+	switch l {
+	case LevelTrace:
+		return zapcore.DebugLevel
+	case LevelDebug:
+		return zapcore.DebugLevel
+	case LevelInfo:
+		return zapcore.InfoLevel
+	case LevelWarn:
+		return zapcore.WarnLevel
+	case LevelError:
+		return zapcore.ErrorLevel
+	case LevelPanic:
+		return zapcore.PanicLevel
+	case LevelFatal:
+		return zapcore.FatalLevel
+	default:
+		return zapcore.Level(l)
+	}
+}
+
+// stacktraceCore wraps a zapcore.Core, attaching a stacktrace to entries at or above level before
+// delegating, letting a single WriterConfig entry capture stacktraces independently of the other
+// writers it is teed alongside.
+type stacktraceCore struct {
+	zapcore.Core
+	level zapcore.Level
+}
+
+// With implements zapcore.Core, keeping the returned core wrapped with the same stacktrace level.
+func (core *stacktraceCore) With(fields []zapcore.Field) zapcore.Core {
+	return &stacktraceCore{Core: core.Core.With(fields), level: core.level}
+}
+
+// Write implements zapcore.Core, populating entry.Stack from the current goroutine when one was
+// not already captured upstream and entry.Level satisfies core.level.
+func (core *stacktraceCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	if entry.Stack == "" && entry.Level >= core.level {
+		entry.Stack = string(debug.Stack())
+	}
+
+	return core.Core.Write(entry, fields)
+}
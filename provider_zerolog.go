@@ -0,0 +1,144 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/rs/zerolog"
+)
+
+// zerologProvider implements Provider using github.com/rs/zerolog.
+type zerologProvider struct {
+	logger zerolog.Logger
+}
+
+// newZerologProvider builds a zerologProvider writer and encoding from config, fanning out to every
+// entry in config.effectiveWriters via zerolog.MultiLevelWriter, each gated by its own Level.
+func newZerologProvider(config Config) (*zerologProvider, error) {
+	writerConfigs := config.effectiveWriters()
+
+	leveled := make([]io.Writer, 0, len(writerConfigs))
+	minLevel := LevelFatal
+
+	for _, writerConfig := range writerConfigs {
+		target, err := zerologTarget(config, writerConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		leveled = append(leveled, &zerologLeveledWriter{out: target, level: writerConfig.Level})
+
+		if writerConfig.Level < minLevel {
+			minLevel = writerConfig.Level
+		}
+	}
+
+	zerolog.TimestampFieldName = KeyTimestamp.String()
+	zerolog.LevelFieldName = KeyLevel.String()
+	zerolog.MessageFieldName = KeyMessage.String()
+	zerolog.TimeFieldFormat = TimestampFormatJSON
+
+	logger := zerolog.New(zerolog.MultiLevelWriter(leveled...)).With().Timestamp().Logger().Level(zerologLevel(minLevel))
+
+	return &zerologProvider{logger: logger}, nil
+}
+
+// zerologTarget opens writerConfig.Target (optionally async-buffered per base config) and wraps it
+// per writerConfig.Format.
+func zerologTarget(base Config, writerConfig WriterConfig) (io.Writer, error) {
+	syncer, err := resolveWriteSyncer(base, writerConfig.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	var writer io.Writer = syncer
+
+	switch writerConfig.Format {
+	case FormatText:
+		writer = zerolog.ConsoleWriter{Out: writer, TimeFormat: TimestampFormatConsole}
+	case FormatJSON:
+		// zerolog writes JSON natively, no extra wrapping required.
+	case FormatLogfmt:
+		writer = &logfmtWriter{out: writer}
+	default:
+		return nil, fmt.Errorf("%w: unknown format: %v", Error, writerConfig.Format)
+	}
+
+	return writer, nil
+}
+
+// zerologLeveledWriter gates writes to out by its own Level, implementing zerolog.LevelWriter so
+// Config.Writers entries can apply a level to each target independently of the shared
+// zerolog.Logger's own minimum level.
+type zerologLeveledWriter struct {
+	out   io.Writer
+	level Level
+}
+
+// Write implements io.Writer for callers that bypass WriteLevel.
+func (w *zerologLeveledWriter) Write(p []byte) (int, error) {
+	return w.out.Write(p)
+}
+
+// WriteLevel implements zerolog.LevelWriter, writing p to out only if level satisfies w.level.
+func (w *zerologLeveledWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	if !w.level.IsEnabledForLevel(fromZerologLevel(level)) {
+		return len(p), nil
+	}
+
+	return w.out.Write(p)
+}
+
+// fromZerologLevel maps a zerolog.Level back to the nearest logging Level. Inverse of zerologLevel.
+func fromZerologLevel(l zerolog.Level) Level {
+	switch l {
+	case zerolog.TraceLevel:
+		return LevelTrace
+	case zerolog.DebugLevel:
+		return LevelDebug
+	case zerolog.InfoLevel:
+		return LevelInfo
+	case zerolog.WarnLevel:
+		return LevelWarn
+	case zerolog.ErrorLevel:
+		return LevelError
+	case zerolog.PanicLevel:
+		return LevelPanic
+	case zerolog.FatalLevel:
+		return LevelFatal
+	default:
+		return Level(l)
+	}
+}
+
+// NewLogger returns a Logger wrapping a zerolog.Logger at the given level.
+func (provider *zerologProvider) NewLogger(_ Config, level Level) (Logger, error) {
+	return &zerologLogger{logger: provider.logger.Level(zerologLevel(level)), level: level}, nil
+}
+
+// Sync is a no-op: zerolog writes synchronously through the underlying io.Writer.
+func (provider *zerologProvider) Sync() error {
+	return nil
+}
+
+// zerologLevel maps logging Level to the underlying zerolog.Level.
+func zerologLevel(l Level) zerolog.Level {
+	switch l {
+	case LevelTrace:
+		return zerolog.TraceLevel
+	case LevelDebug:
+		return zerolog.DebugLevel
+	case LevelInfo:
+		return zerolog.InfoLevel
+	case LevelWarn:
+		return zerolog.WarnLevel
+	case LevelError:
+		return zerolog.ErrorLevel
+	case LevelPanic:
+		return zerolog.PanicLevel
+	case LevelFatal:
+		return zerolog.FatalLevel
+	default:
+		return zerolog.Level(l)
+	}
+}
@@ -0,0 +1,117 @@
+package logging
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+var (
+	registryMu sync.Mutex
+	registry   = make(map[string]Logger)
+)
+
+// RegisterPackage registers a named Logger for the calling package and remembers it in a
+// package-wide registry so SetAllLogLevel, SetPackageLogLevel and UpdateAllLoggers can later
+// reconfigure it without the caller threading a Logger through every constructor.
+// If name is empty it is derived from the caller's package via runtime.Caller.
+// The registered logger honors any level already configured for name in Config.CustomLevels.
+// If name is already registered, the Logger registered for it is returned as is, including any
+// fields added since by UpdateAllLoggers.
+func RegisterPackage(name string, opts ...Option) (Logger, error) {
+	if name == "" {
+		if name = callerPackageName(); name == "" {
+			return nil, fmt.Errorf("%w: register package: unable to derive caller package name", Error)
+		}
+	}
+
+	registryMu.Lock()
+	existing, registered := registry[name]
+	registryMu.Unlock()
+
+	if registered {
+		return existing, nil
+	}
+
+	config := *CurrentConfig()
+	config.Apply(opts...)
+
+	logger := NewNamedLogger(name, config.levelForNamed(name))
+
+	registryMu.Lock()
+	registry[name] = logger
+	registryMu.Unlock()
+
+	return logger, nil
+}
+
+// SetAllLogLevel walks every Logger registered with RegisterPackage and reconfigures it to level.
+func SetAllLogLevel(level Level) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	for _, logger := range registry {
+		if setter, ok := logger.(levelSetter); ok {
+			setter.setLevel(level)
+		}
+	}
+}
+
+// SetPackageLogLevel reconfigures the Logger registered for name to level.
+// Returns error if name was never registered with RegisterPackage.
+func SetPackageLogLevel(name string, level Level) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	logger, ok := registry[name]
+	if !ok {
+		return fmt.Errorf("%w: package %s not registered", Error, name)
+	}
+
+	setter, ok := logger.(levelSetter)
+	if !ok {
+		return fmt.Errorf("%w: logger for %s does not support runtime level changes", Error, name)
+	}
+
+	setter.setLevel(level)
+
+	return nil
+}
+
+// UpdateAllLoggers appends fields to the base context of every Logger registered with
+// RegisterPackage. Callers holding a previously registered Logger should re-fetch it via
+// RegisterPackage to observe the new fields, as Logger instances themselves remain immutable.
+func UpdateAllLoggers(fields Keys) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	for name, logger := range registry {
+		registry[name] = logger.WithKeys(fields)
+	}
+}
+
+// callerPackageName derives a package name from the caller two frames above it
+// (i.e. the caller of RegisterPackage), using Go's fully qualified function name.
+func callerPackageName() string {
+	pc, _, _, ok := runtime.Caller(2)
+	if !ok {
+		return ""
+	}
+
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return ""
+	}
+
+	fullName := fn.Name() // e.g. "github.com/amarin/logging_test.TestRegisterPackage.func1"
+	if idx := strings.LastIndex(fullName, "/"); idx >= 0 {
+		fullName = fullName[idx+1:]
+	}
+
+	if idx := strings.Index(fullName, "."); idx >= 0 {
+		fullName = fullName[:idx]
+	}
+
+	return fullName
+}
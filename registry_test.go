@@ -0,0 +1,52 @@
+package logging_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/logging"
+)
+
+func TestRegisterPackage(t *testing.T) {
+	require.NoError(t, logging.Init())
+
+	t.Run("derives name from caller package", func(t *testing.T) {
+		logger, err := logging.RegisterPackage("")
+		require.NoError(t, err)
+		require.NotNil(t, logger)
+	})
+
+	t.Run("honors explicit name", func(t *testing.T) {
+		logger, err := logging.RegisterPackage("registry-test")
+		require.NoError(t, err)
+		require.Equal(t, logging.DefaultLevel, logger.Level())
+	})
+}
+
+func TestSetPackageLogLevel(t *testing.T) {
+	require.NoError(t, logging.Init())
+
+	logger, err := logging.RegisterPackage("registry-level-test")
+	require.NoError(t, err)
+	require.Equal(t, logging.DefaultLevel, logger.Level())
+
+	require.NoError(t, logging.SetPackageLogLevel("registry-level-test", logging.LevelDebug))
+	require.Equal(t, logging.LevelDebug, logger.Level())
+
+	require.Error(t, logging.SetPackageLogLevel("unknown-package", logging.LevelDebug))
+}
+
+func TestSetAllLogLevel(t *testing.T) {
+	require.NoError(t, logging.Init())
+
+	first, err := logging.RegisterPackage("registry-all-first")
+	require.NoError(t, err)
+	second, err := logging.RegisterPackage("registry-all-second")
+	require.NoError(t, err)
+
+	logging.SetAllLogLevel(logging.LevelError)
+
+	require.Equal(t, logging.LevelError, first.Level())
+	require.Equal(t, logging.LevelError, second.Level())
+}
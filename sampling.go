@@ -0,0 +1,340 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const samplingShardCount = 16
+
+// WithSampling enables sampling, mirroring zap's own sampler: within each 1/tickPerSec-second
+// window, the first records of a given (level, message) fingerprint pass through, then only every
+// thereafter-th record with the same fingerprint until the window resets. Fatal always bypasses
+// sampling. Disabled (a no-op Option) when tickPerSec is zero or less.
+//
+// This is the one sampler this package ships: a later request asked again for sampling support,
+// specifically zapcore.NewSamplerWithOptions wrapping the zap core directly plus a WithoutSampling
+// escape hatch. That duplicates what WithSampling/WithSamplingDisabled already do at the Logger
+// layer, so it was not built a second time — Backend.SamplingDropped was added instead, as the
+// genuinely new part of that request (a suppression counter), layered onto this existing sampler
+// rather than a zapcore-native one.
+func WithSampling(tickPerSec int, first int, thereafter int) Option {
+	return func(config *Config) {
+		config.SamplingTickPerSec = tickPerSec
+		config.SamplingFirst = first
+		config.SamplingThereafter = thereafter
+	}
+}
+
+// samplingCounter tracks how many records a single (level, message) fingerprint has seen within
+// its current window.
+type samplingCounter struct {
+	windowStart int64
+	count       int64
+}
+
+// samplingShard is one of samplingState's striped locks, reducing contention across fingerprints
+// that hash to different shards.
+type samplingShard struct {
+	mu      sync.Mutex
+	entries map[uint64]*samplingCounter
+}
+
+// samplingState holds the sampling configuration and counters shared by a samplingLogger and every
+// logger derived from it via WithKeys/WithKey/WithError/WithLevel/WithContext.
+type samplingState struct {
+	tickPerSec int
+	first      int
+	thereafter int
+	shards     [samplingShardCount]samplingShard
+	dropped    *atomic.Uint64 // shared by every samplingState a Backend creates; see Backend.SamplingDropped
+}
+
+// newSamplingState builds a samplingState with all shards ready to use. dropped is shared across
+// every samplingState built for the same Backend, so Backend.SamplingDropped reports suppression
+// totals across all loggers it vended, not just the one samplingState belongs to.
+func newSamplingState(tickPerSec, first, thereafter int, dropped *atomic.Uint64) *samplingState {
+	state := &samplingState{tickPerSec: tickPerSec, first: first, thereafter: thereafter, dropped: dropped}
+	for i := range state.shards {
+		state.shards[i].entries = make(map[uint64]*samplingCounter)
+	}
+
+	return state
+}
+
+// shouldLog decides whether a record at level with the given message fingerprint passes through,
+// advancing/resetting that fingerprint's window counter as a side effect.
+func (state *samplingState) shouldLog(level Level, message string) bool {
+	key := samplingKey(level, message)
+	shard := &state.shards[key%samplingShardCount]
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	counter, ok := shard.entries[key]
+	if !ok {
+		counter = new(samplingCounter)
+		shard.entries[key] = counter
+	}
+
+	now := samplingTick(state.tickPerSec)
+	if counter.windowStart != now {
+		counter.windowStart = now
+		counter.count = 0
+	}
+
+	counter.count++
+
+	if counter.count <= int64(state.first) {
+		return true
+	}
+
+	if state.thereafter <= 0 || (counter.count-int64(state.first))%int64(state.thereafter) != 0 {
+		if state.dropped != nil {
+			state.dropped.Add(1)
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// samplingKey computes the fingerprint used to bucket a record, FNV-1a hashing message and mixing
+// in level so the same text at different levels is tracked independently.
+func samplingKey(level Level, message string) uint64 {
+	h := fnv.New64a()
+	_, _ = io.WriteString(h, message)
+
+	return uint64(level)<<8 ^ h.Sum64()
+}
+
+// samplingTick returns the current window index for tickPerSec windows per second.
+func samplingTick(tickPerSec int) int64 {
+	if tickPerSec <= 0 {
+		tickPerSec = 1
+	}
+
+	return time.Now().UnixNano() * int64(tickPerSec) / int64(time.Second)
+}
+
+// samplingLogger implements Logger, rate-limiting records at the same (level, message) fingerprint
+// before delegating to next. Inserted between the Logger façade Backend vends and the provider
+// logger it wraps. Every level-gated method checks next.IsEnabledForLevel first and returns
+// immediately when the level is disabled, so a disabled-level call never pays for fingerprint
+// hashing or a shard lookup it would discard anyway.
+type samplingLogger struct {
+	next     Logger
+	state    *samplingState
+	disabled bool
+}
+
+// newSamplingLogger wraps next with sampling per state. dropped, when non-nil, accumulates a count
+// of every record this and every sibling samplingLogger sharing it suppresses; see
+// Backend.SamplingDropped.
+func newSamplingLogger(next Logger, tickPerSec, first, thereafter int, dropped *atomic.Uint64) Logger {
+	return &samplingLogger{next: next, state: newSamplingState(tickPerSec, first, thereafter, dropped)}
+}
+
+// derive copies s, swapping in next, for use by the With* family.
+func (s *samplingLogger) derive(next Logger) Logger {
+	return &samplingLogger{next: next, state: s.state, disabled: s.disabled}
+}
+
+// Level returns the wrapped logger's current level.
+func (s *samplingLogger) Level() Level {
+	return s.next.Level()
+}
+
+// IsEnabledForLevel delegates to the wrapped logger; sampling only bounds volume, not level.
+func (s *samplingLogger) IsEnabledForLevel(level Level) bool {
+	return s.next.IsEnabledForLevel(level)
+}
+
+// Trace sends trace data onto the wrapped logger if sampling allows it.
+func (s *samplingLogger) Trace(args ...interface{}) {
+	if !s.next.IsEnabledForLevel(LevelTrace) {
+		return
+	}
+
+	if s.disabled || s.state.shouldLog(LevelTrace, fmt.Sprint(args...)) {
+		s.next.Trace(args...)
+	}
+}
+
+// Tracef sends message template and filling arguments onto the wrapped logger if sampling allows it.
+func (s *samplingLogger) Tracef(format string, args ...interface{}) {
+	if !s.next.IsEnabledForLevel(LevelTrace) {
+		return
+	}
+
+	if s.disabled || s.state.shouldLog(LevelTrace, format) {
+		s.next.Tracef(format, args...)
+	}
+}
+
+// Debug sends debug data onto the wrapped logger if sampling allows it.
+func (s *samplingLogger) Debug(args ...interface{}) {
+	if !s.next.IsEnabledForLevel(LevelDebug) {
+		return
+	}
+
+	if s.disabled || s.state.shouldLog(LevelDebug, fmt.Sprint(args...)) {
+		s.next.Debug(args...)
+	}
+}
+
+// Debugf sends message template and filling arguments onto the wrapped logger if sampling allows it.
+func (s *samplingLogger) Debugf(format string, args ...interface{}) {
+	if !s.next.IsEnabledForLevel(LevelDebug) {
+		return
+	}
+
+	if s.disabled || s.state.shouldLog(LevelDebug, format) {
+		s.next.Debugf(format, args...)
+	}
+}
+
+// Info sends info level data onto the wrapped logger if sampling allows it.
+func (s *samplingLogger) Info(args ...interface{}) {
+	if !s.next.IsEnabledForLevel(LevelInfo) {
+		return
+	}
+
+	if s.disabled || s.state.shouldLog(LevelInfo, fmt.Sprint(args...)) {
+		s.next.Info(args...)
+	}
+}
+
+// Infof sends message template and filling arguments onto the wrapped logger if sampling allows it.
+func (s *samplingLogger) Infof(format string, args ...interface{}) {
+	if !s.next.IsEnabledForLevel(LevelInfo) {
+		return
+	}
+
+	if s.disabled || s.state.shouldLog(LevelInfo, format) {
+		s.next.Infof(format, args...)
+	}
+}
+
+// Warn sends warn data onto the wrapped logger if sampling allows it.
+func (s *samplingLogger) Warn(args ...interface{}) {
+	if !s.next.IsEnabledForLevel(LevelWarn) {
+		return
+	}
+
+	if s.disabled || s.state.shouldLog(LevelWarn, fmt.Sprint(args...)) {
+		s.next.Warn(args...)
+	}
+}
+
+// Warnf sends message template and filling arguments onto the wrapped logger if sampling allows it.
+func (s *samplingLogger) Warnf(format string, args ...interface{}) {
+	if !s.next.IsEnabledForLevel(LevelWarn) {
+		return
+	}
+
+	if s.disabled || s.state.shouldLog(LevelWarn, format) {
+		s.next.Warnf(format, args...)
+	}
+}
+
+// Error sends error data onto the wrapped logger if sampling allows it.
+func (s *samplingLogger) Error(args ...interface{}) {
+	if !s.next.IsEnabledForLevel(LevelError) {
+		return
+	}
+
+	if s.disabled || s.state.shouldLog(LevelError, fmt.Sprint(args...)) {
+		s.next.Error(args...)
+	}
+}
+
+// Errorf sends message template and filling arguments onto the wrapped logger if sampling allows it.
+func (s *samplingLogger) Errorf(format string, args ...interface{}) {
+	if !s.next.IsEnabledForLevel(LevelError) {
+		return
+	}
+
+	if s.disabled || s.state.shouldLog(LevelError, format) {
+		s.next.Errorf(format, args...)
+	}
+}
+
+// Fatal sends error data onto the wrapped logger and calls os.exit(1). Never sampled.
+func (s *samplingLogger) Fatal(args ...interface{}) {
+	s.next.Fatal(args...)
+}
+
+// Fatalf sends message template and filling arguments onto the wrapped logger and calls os.exit(1).
+// Never sampled.
+func (s *samplingLogger) Fatalf(format string, args ...interface{}) {
+	s.next.Fatalf(format, args...)
+}
+
+// WithKeys provides a new sampling logger instance propagating fields to next and sharing state.
+func (s *samplingLogger) WithKeys(fields Keys) Logger {
+	return s.derive(s.next.WithKeys(fields))
+}
+
+// WithKey provides a new sampling logger instance having specified key-value set.
+func (s *samplingLogger) WithKey(key string, value any) Logger {
+	return s.derive(s.next.WithKey(key, value))
+}
+
+// WithError provides a new sampling logger instance having specified error key.
+func (s *samplingLogger) WithError(err error) Logger {
+	return s.derive(s.next.WithError(err))
+}
+
+// WithLevel provides a new sampling logger instance inheriting settings from parent except level.
+func (s *samplingLogger) WithLevel(level Level) Logger {
+	return s.derive(s.next.WithLevel(level))
+}
+
+// WithContext takes data from specified context and applies it to the wrapped logger.
+func (s *samplingLogger) WithContext(ctx context.Context) Logger {
+	return s.derive(s.next.WithContext(ctx))
+}
+
+// InfoCtx sends info level data onto the wrapped logger, enriched with ctx, if sampling allows it.
+func (s *samplingLogger) InfoCtx(ctx context.Context, args ...interface{}) {
+	if !s.next.IsEnabledForLevel(LevelInfo) {
+		return
+	}
+
+	if s.disabled || s.state.shouldLog(LevelInfo, fmt.Sprint(args...)) {
+		s.next.WithContext(ctx).Info(args...)
+	}
+}
+
+// WithErrorCtx provides a new sampling logger instance having specified error key and ctx applied.
+func (s *samplingLogger) WithErrorCtx(ctx context.Context, err error) Logger {
+	return s.derive(s.next.WithContext(ctx).WithError(err))
+}
+
+// WithValues is an alias for WithContext.
+func (s *samplingLogger) WithValues(ctx context.Context) Logger {
+	return s.WithContext(ctx)
+}
+
+// WithSamplingDisabled returns a logger instance that bypasses sampling entirely, for audit-critical
+// call sites that must never drop a record.
+func (s *samplingLogger) WithSamplingDisabled() Logger {
+	return &samplingLogger{next: s.next, state: s.state, disabled: true}
+}
+
+// Sync flushes the wrapped logger if it supports Syncer, preserving NewLoggerCtx/NewNamedLoggerCtx's
+// flush-on-context-done behavior through the sampling wrapper.
+func (s *samplingLogger) Sync() error {
+	if syncer, ok := s.next.(Syncer); ok {
+		return syncer.Sync()
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package logging_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/logging"
+)
+
+func TestBackend_WithSampling_FirstAndThereafter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sampled.log")
+
+	config := logging.NewConfig()
+	config.Apply(
+		logging.WithEventWriter("file", logging.WriterConfig{
+			Target: logging.Target(path),
+			Format: logging.FormatJSON,
+			Level:  logging.LevelInfo,
+		}),
+		logging.WithSampling(1, 2, 5),
+	)
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	logger := backend.NewLogger(logging.LevelInfo)
+	for i := 0; i < 12; i++ {
+		logger.Info("burst message")
+	}
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// first=2 pass unconditionally (records 1,2), then every 5th thereafter (records 7,12): 4 total.
+	require.Equal(t, 4, strings.Count(string(content), "burst message"))
+}
+
+func TestBackend_SamplingDropped(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dropped.log")
+
+	config := logging.NewConfig()
+	config.Apply(
+		logging.WithEventWriter("file", logging.WriterConfig{
+			Target: logging.Target(path),
+			Format: logging.FormatJSON,
+			Level:  logging.LevelInfo,
+		}),
+		logging.WithSampling(1, 2, 5),
+	)
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	require.Zero(t, backend.SamplingDropped())
+
+	logger := backend.NewLogger(logging.LevelInfo)
+	for i := 0; i < 12; i++ {
+		logger.Info("burst message")
+	}
+
+	// 12 records, 4 pass (see TestBackend_WithSampling_FirstAndThereafter): 8 dropped.
+	require.EqualValues(t, 8, backend.SamplingDropped())
+
+	// A second logger from the same Backend has its own fingerprint counters (first 2 pass again),
+	// but accumulates dropped records onto the same shared counter: 2 more of 4 dropped here.
+	second := backend.NewLogger(logging.LevelInfo)
+	for i := 0; i < 4; i++ {
+		second.Info("burst message")
+	}
+	require.EqualValues(t, 10, backend.SamplingDropped())
+}
+
+func TestBackend_WithSampling_WithSamplingDisabledBypasses(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "unsampled.log")
+
+	config := logging.NewConfig()
+	config.Apply(
+		logging.WithEventWriter("file", logging.WriterConfig{
+			Target: logging.Target(path),
+			Format: logging.FormatJSON,
+			Level:  logging.LevelInfo,
+		}),
+		logging.WithSampling(1, 1, 100),
+	)
+
+	backend := new(logging.Backend)
+	require.NoError(t, backend.Init(*config))
+
+	logger := backend.NewLogger(logging.LevelInfo).WithSamplingDisabled()
+	for i := 0; i < 5; i++ {
+		logger.Info("audit message")
+	}
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 5, strings.Count(string(content), "audit message"))
+}
@@ -118,6 +118,26 @@ func (s *LockedWriter) Sync() error {
 	return s.stream.Sync()
 }
 
+// Rotate triggers rotation on the underlying writer if it implements Rotator (e.g. a
+// *lumberjack.Logger opened via RotatingOutput/WithRotation), and is a no-op otherwise.
+func (s *LockedWriter) Rotate() error {
+	s.mu.Lock()
+	stream := s.stream
+	s.mu.Unlock()
+
+	var target io.Writer = stream
+
+	if wrapper, ok := stream.(*syncerWrapper); ok {
+		target = wrapper.Writer
+	}
+
+	if rotator, ok := target.(Rotator); ok {
+		return rotator.Rotate()
+	}
+
+	return nil
+}
+
 // Output returns io.Writer to use in Backend instances or error if output open/create failed.
 func Output(output string) (writer *LockedWriter, err error) {
 	return writers.registeredOutput(output)
@@ -130,6 +150,7 @@ type WriterName string
 type WritersRegistry struct {
 	mu      sync.Mutex                   // protect underlying map
 	writers map[WriterName]*LockedWriter // writes mapping itself
+	async   map[WriterName]*AsyncWriter  // async wrappers, keyed by the same output name as writers
 }
 
 // newWritersRegistry makes a new WritersRegistry
@@ -137,6 +158,7 @@ func newWritersRegistry() *WritersRegistry {
 	return &WritersRegistry{
 		mu:      sync.Mutex{},
 		writers: make(map[WriterName]*LockedWriter),
+		async:   make(map[WriterName]*AsyncWriter),
 	}
 }
 
@@ -231,3 +253,19 @@ func (registry *WritersRegistry) registeredOutput(output string) (writer *Locked
 
 	return writer, nil
 }
+
+// registeredRotatingOutput returns a locked writer wrapping a *lumberjack.Logger for output,
+// reusing an existing entry (rotating or not) if output was already opened.
+func (registry *WritersRegistry) registeredRotatingOutput(output string, rotation RotationConfig) (writer *LockedWriter, err error) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if writer, ok := registry.writers[WriterName(output)]; ok {
+		return writer, nil
+	}
+
+	writer = wrap(newLumberjackWriter(Target(output), rotation))
+	registry.writers[WriterName(output)] = writer
+
+	return writer, nil
+}
@@ -0,0 +1,237 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+)
+
+const (
+	// DropOldest discards the oldest buffered entry to make room for the incoming one when
+	// AsyncWriter's buffer is full.
+	DropOldest OverflowPolicy = iota
+
+	// DropNewest discards the incoming entry, leaving AsyncWriter's buffer unchanged.
+	DropNewest
+
+	// Block makes the caller wait until the drainer goroutine consumes an entry, applying
+	// backpressure instead of dropping anything.
+	Block
+)
+
+// OverflowPolicy selects AsyncWriter behavior once its buffer channel is full.
+type OverflowPolicy int
+
+// AsyncWriter wraps a WriteSyncer with a bounded channel of buffered writes drained by a single
+// background goroutine, so producers on the hot path never block behind a slow sink (file, syslog).
+// Write copies p onto the channel and returns immediately; once the channel is full, behavior
+// follows the configured OverflowPolicy.
+type AsyncWriter struct {
+	stream WriteSyncer
+	policy OverflowPolicy
+
+	queue chan []byte
+	sync  chan chan struct{}
+	stop  chan struct{}
+	done  chan struct{}
+
+	closed chan struct{}
+}
+
+// wrapAsync wraps stream into an AsyncWriter buffering up to size entries under policy and starts
+// its background drainer goroutine.
+func wrapAsync(stream WriteSyncer, size int, policy OverflowPolicy) *AsyncWriter {
+	writer := &AsyncWriter{
+		stream: stream,
+		policy: policy,
+		queue:  make(chan []byte, size),
+		sync:   make(chan chan struct{}),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+		closed: make(chan struct{}),
+	}
+
+	go writer.drain()
+
+	return writer
+}
+
+// drain reads buffered entries off queue and writes them to stream until stop is signalled, then
+// drains whatever remains queued before returning.
+func (w *AsyncWriter) drain() {
+	defer close(w.done)
+
+	for {
+		select {
+		case p := <-w.queue:
+			_, _ = w.stream.Write(p) // best effort; sink errors are not observable by the caller
+		case ack := <-w.sync:
+			w.drainQueue()
+			close(ack)
+		case <-w.stop:
+			w.drainQueue()
+			return
+		}
+	}
+}
+
+// drainQueue flushes whatever is currently buffered in queue without blocking.
+func (w *AsyncWriter) drainQueue() {
+	for {
+		select {
+		case p := <-w.queue:
+			_, _ = w.stream.Write(p)
+		default:
+			return
+		}
+	}
+}
+
+// Write buffers p for the background drainer per the configured OverflowPolicy and returns without
+// waiting on the sink, except under Block, where it waits for room while the buffer is full; it
+// never returns a partial write.
+func (w *AsyncWriter) Write(p []byte) (n int, err error) {
+	select {
+	case <-w.closed:
+		return 0, ErrClosed
+	default:
+	}
+
+	buf := make([]byte, len(p))
+	copy(buf, p)
+
+	switch w.policy {
+	case Block:
+		w.queue <- buf
+	case DropNewest:
+		select {
+		case w.queue <- buf:
+		default:
+		}
+	case DropOldest:
+		select {
+		case w.queue <- buf:
+		default:
+			select {
+			case <-w.queue:
+			default:
+			}
+			select {
+			case w.queue <- buf:
+			default:
+			}
+		}
+	}
+
+	return len(p), nil
+}
+
+// Sync drains any buffered entries into stream and flushes it.
+func (w *AsyncWriter) Sync() error {
+	select {
+	case <-w.closed:
+		return w.stream.Sync()
+	default:
+	}
+
+	ack := make(chan struct{})
+	w.sync <- ack
+	<-ack
+
+	return w.stream.Sync()
+}
+
+// Close signals the drainer to stop, waits for it to flush whatever is queued, then closes the
+// wrapped stream if it implements io.Closer.
+func (w *AsyncWriter) Close() error {
+	select {
+	case <-w.closed:
+		return nil
+	default:
+		close(w.closed)
+	}
+
+	close(w.stop)
+	<-w.done
+
+	if closer, ok := w.stream.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// registeredAsyncOutput returns the AsyncWriter wrapping the named target, building and caching one
+// against registry.async on first request so backends sharing the same target share one drainer.
+func (registry *WritersRegistry) registeredAsyncOutput(
+	output string, size int, policy OverflowPolicy,
+) (writer *AsyncWriter, err error) {
+	return registry.registeredAsyncOutputWith(output, size, policy, func() (*LockedWriter, error) {
+		return registry.registeredOutput(output)
+	})
+}
+
+// registeredAsyncRotatingOutput is registeredAsyncOutput's counterpart for a rotating (lumberjack)
+// target, sharing the same caching and drainer machinery.
+func (registry *WritersRegistry) registeredAsyncRotatingOutput(
+	output string, rotation RotationConfig, size int, policy OverflowPolicy,
+) (writer *AsyncWriter, err error) {
+	return registry.registeredAsyncOutputWith(output, size, policy, func() (*LockedWriter, error) {
+		return registry.registeredRotatingOutput(output, rotation)
+	})
+}
+
+// registeredAsyncOutputWith builds and caches the AsyncWriter for output against registry.async,
+// using resolve to open the underlying target on first request so backends sharing the same
+// output share one drainer regardless of whether it is a plain or rotating target.
+func (registry *WritersRegistry) registeredAsyncOutputWith(
+	output string, size int, policy OverflowPolicy, resolve func() (*LockedWriter, error),
+) (writer *AsyncWriter, err error) {
+	var target *LockedWriter
+
+	registry.mu.Lock()
+	if writer, ok := registry.async[WriterName(output)]; ok {
+		registry.mu.Unlock()
+		return writer, nil
+	}
+	registry.mu.Unlock()
+
+	if target, err = resolve(); err != nil {
+		return nil, err
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if writer, ok := registry.async[WriterName(output)]; ok {
+		return writer, nil
+	}
+
+	if registry.async == nil {
+		registry.async = make(map[WriterName]*AsyncWriter)
+	}
+
+	writer = wrapAsync(target, size, policy)
+	registry.async[WriterName(output)] = writer
+
+	return writer, nil
+}
+
+// AsyncOutput returns an AsyncWriter for specified output, buffering up to size writes under
+// policy before they reach the underlying sink, or error if the output itself failed to open.
+func AsyncOutput(output string, size int, policy OverflowPolicy) (*AsyncWriter, error) {
+	writer, err := writers.registeredAsyncOutput(output, size, policy)
+	if err != nil {
+		return nil, fmt.Errorf("%w: async output: %v", Error, err)
+	}
+
+	return writer, nil
+}
+
+// WithAsyncBuffer enables asynchronous buffered writing with a channel of size entries and the
+// specified overflow policy once the channel is full.
+func WithAsyncBuffer(size int, onOverflow OverflowPolicy) Option {
+	return func(config *Config) {
+		config.AsyncBufferSize = size
+		config.AsyncOverflowPolicy = onOverflow
+	}
+}
@@ -0,0 +1,57 @@
+package logging_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/logging"
+)
+
+func TestAsyncWriter_WriteSync(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "async.log")
+
+	writer, err := logging.AsyncOutput(path, 4, logging.Block)
+	require.NoError(t, err)
+
+	_, err = writer.Write([]byte("line one\n"))
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("line two\n"))
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Sync())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "line one\nline two\n", string(content))
+}
+
+func TestAsyncWriter_SharedAcrossBackends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shared.log")
+
+	first, err := logging.AsyncOutput(path, 4, logging.DropNewest)
+	require.NoError(t, err)
+
+	second, err := logging.AsyncOutput(path, 4, logging.DropNewest)
+	require.NoError(t, err)
+
+	require.Same(t, first, second)
+}
+
+func TestAsyncWriter_Close(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "close.log")
+
+	writer, err := logging.AsyncOutput(path, 4, logging.DropOldest)
+	require.NoError(t, err)
+
+	_, err = writer.Write([]byte("buffered\n"))
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Close())
+	require.NoError(t, writer.Close()) // closing twice is a no-op
+
+	_, err = writer.Write([]byte("after close"))
+	require.ErrorIs(t, err, logging.ErrClosed)
+}